@@ -0,0 +1,196 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ComponentsReadyCondition is the condition of an Application summarizing the health of its Components
+	ComponentsReadyCondition = "ComponentsReady"
+
+	// Reasons of the ComponentsReady condition
+	ComponentsReadyReason   = "ComponentsReady"
+	ComponentsPendingReason = "ComponentsPending"
+	ComponentsFailedReason  = "ComponentsFailed"
+	NoComponentsReason      = "NoComponents"
+)
+
+// Condition types of a Component which tell whether it is ready
+const (
+	// ComponentCreatedCondition is whether the resources of the Component were created
+	ComponentCreatedCondition = "Created"
+
+	// ComponentUpdatedCondition is whether the last update of the Component was applied
+	ComponentUpdatedCondition = "Updated"
+
+	// ComponentBuildCondition is whether the builds of the Component are configured and succeeding
+	ComponentBuildCondition = "Build"
+)
+
+// ComponentReadinessConditions are the condition types of a Component which must not be False for it to be
+// ready, see ComputeComponentHealth. Conditions of other types do not affect its health.
+var ComponentReadinessConditions = []string{ComponentCreatedCondition, ComponentUpdatedCondition, ComponentBuildCondition}
+
+// ComponentHealthState is the health of a Component, see ComputeComponentHealth
+type ComponentHealthState string
+
+const (
+	// ComponentHealthReady is a Component whose versions are all onboarded, without failure
+	ComponentHealthReady ComponentHealthState = "Ready"
+
+	// ComponentHealthPending is a Component with versions being onboarded, updated or offboarded
+	ComponentHealthPending ComponentHealthState = "Pending"
+
+	// ComponentHealthFailed is a Component with a failed condition, an error message or a version that failed to onboard
+	ComponentHealthFailed ComponentHealthState = "Failed"
+)
+
+// ComponentHealth is the health of a Component
+// +kubebuilder:object:generate=false
+// +protobuf=false
+type ComponentHealth struct {
+	// Name is the name of the Component
+	Name string
+
+	// State is the health of the Component
+	State ComponentHealthState
+
+	// Message explains why the Component is pending or failed, it is empty for ready Components
+	Message string
+}
+
+// ApplicationHealth is the aggregated health of the Components of an Application
+// +kubebuilder:object:generate=false
+// +protobuf=false
+type ApplicationHealth struct {
+	// Components is the health of each Component of the Application, sorted by name
+	Components []ComponentHealth
+
+	// Ready, Pending and Failed count the Components in each state
+	Ready   int
+	Pending int
+	Failed  int
+}
+
+// Total returns the number of Components of the Application.
+func (h *ApplicationHealth) Total() int {
+	return len(h.Components)
+}
+
+// ComputeComponentHealth returns the health of a Component from its status:
+//   - it failed if one of its ComponentReadinessConditions is False, if its status has a general error message,
+//     or if a version failed to onboard;
+//   - it is pending if a version of its spec is not onboarded yet, or if versions have to be updated or offboarded,
+//     or duplicate version statuses removed;
+//   - otherwise it is ready.
+func ComputeComponentHealth(component *Component) ComponentHealth {
+	health := ComponentHealth{Name: component.Name, State: ComponentHealthReady}
+	failed := func(message string) ComponentHealth {
+		health.State = ComponentHealthFailed
+		health.Message = message
+		return health
+	}
+
+	for _, conditionType := range ComponentReadinessConditions {
+		condition := meta.FindStatusCondition(component.Status.Conditions, conditionType)
+		if condition != nil && condition.Status == metav1.ConditionFalse {
+			return failed(fmt.Sprintf(ComponentConditionFailedMessage, condition.Type, condition.Status, condition.Message))
+		}
+	}
+	if component.Status.Message != "" {
+		return failed(component.Status.Message)
+	}
+	for _, version := range component.Status.Versions {
+		if version.Onboarding() == ComponentOnboardingFailed {
+			return failed(fmt.Sprintf(ComponentVersionOnboardingError, version.Name, version.Message))
+		}
+	}
+
+	plan, err := PlanComponentVersions(component)
+	if err != nil {
+		return failed(err.Error())
+	}
+	switch {
+	case len(plan.Onboard) > 0:
+		health.State = ComponentHealthPending
+		health.Message = fmt.Sprintf(ComponentVersionPendingMessage, plan.Onboard[0].Name)
+	case plan.HasChanges():
+		health.State = ComponentHealthPending
+	}
+	return health
+}
+
+// ComputeApplicationHealth returns the health of the Components of an Application, among the given Components
+// which may include Components of other Applications or namespaces.
+func ComputeApplicationHealth(application *Application, components []Component) ApplicationHealth {
+	health := ApplicationHealth{}
+	for i := range components {
+		component := &components[i]
+		if component.Spec.Application != application.Name || component.Namespace != application.Namespace {
+			continue
+		}
+		componentHealth := ComputeComponentHealth(component)
+		switch componentHealth.State {
+		case ComponentHealthReady:
+			health.Ready++
+		case ComponentHealthPending:
+			health.Pending++
+		case ComponentHealthFailed:
+			health.Failed++
+		}
+		health.Components = append(health.Components, componentHealth)
+	}
+	sort.Slice(health.Components, func(i, j int) bool {
+		return health.Components[i].Name < health.Components[j].Name
+	})
+	return health
+}
+
+// SetApplicationHealth computes the health of the Components of an Application and records it in its status:
+// the componentsReady and componentsTotal counters and the ComponentsReady condition.
+func SetApplicationHealth(application *Application, components []Component) ApplicationHealth {
+	health := ComputeApplicationHealth(application, components)
+	application.Status.ComponentsReady = int32(health.Ready)
+	application.Status.ComponentsTotal = int32(health.Total())
+
+	condition := metav1.Condition{
+		Type:               ComponentsReadyCondition,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: application.Generation,
+		Message:            fmt.Sprintf(ComponentsReadyMessage, health.Ready, health.Total()),
+	}
+	switch {
+	case health.Total() == 0:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = NoComponentsReason
+	case health.Failed > 0:
+		condition.Reason = ComponentsFailedReason
+	case health.Pending > 0:
+		condition.Reason = ComponentsPendingReason
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ComponentsReadyReason
+	}
+	meta.SetStatusCondition(&application.Status.Conditions, condition)
+	return health
+}
@@ -28,26 +28,26 @@ type ApplicationSpec struct {
 	// DisplayName refers to the name that an application will be deployed with in App Studio.
 	// Required.
 	// +required
-	DisplayName string `json:"displayName"`
+	DisplayName string `json:"displayName" protobuf:"bytes,1,opt,name=displayName"`
 
 	// AppModelRepository refers to the git repository that will store the application model (a devfile)
 	// Can be the same as GitOps repository.
 	// A repository will be generated if this field is left blank.
 	// Optional.
 	// +optional
-	AppModelRepository ApplicationGitRepository `json:"appModelRepository,omitempty"`
+	AppModelRepository ApplicationGitRepository `json:"appModelRepository,omitempty" protobuf:"bytes,2,opt,name=appModelRepository"`
 
 	// GitOpsRepository refers to the git repository that will store the gitops resources.
 	// Can be the same as App Model Repository.
 	// A repository will be generated if this field is left blank.
 	// Optional.
 	// +optional
-	GitOpsRepository ApplicationGitRepository `json:"gitOpsRepository,omitempty"`
+	GitOpsRepository ApplicationGitRepository `json:"gitOpsRepository,omitempty" protobuf:"bytes,3,opt,name=gitOpsRepository"`
 
 	// Description refers to a brief description of the application.
 	// Optional.
 	// +optional
-	Description string `json:"description,omitempty"`
+	Description string `json:"description,omitempty" protobuf:"bytes,4,opt,name=description"`
 }
 
 // ApplicationGitRepository defines a git repository for a given Application resource (either appmodel or gitops)
@@ -57,28 +57,40 @@ type ApplicationGitRepository struct {
 	// Example: https://github.com/devfile-test/myrepo.
 	// Required.
 	// +required
-	URL string `json:"url"`
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
 
 	// Branch corresponds to the branch in the repository that should be used
 	// Example: devel.
 	// Optional.
 	// +optional
-	Branch string `json:"branch,omitempty"`
+	Branch string `json:"branch,omitempty" protobuf:"bytes,2,opt,name=branch"`
 
 	// Context corresponds to the context within the repository that should be used
 	// Example: folderA/folderB/gitops.
 	// Optional.
 	// +optional
-	Context string `json:"context,omitempty"`
+	Context string `json:"context,omitempty" protobuf:"bytes,3,opt,name=context"`
 }
 
 // ApplicationStatus defines the observed state of Application
 type ApplicationStatus struct {
 	// Conditions is an array of the Application's status conditions
-	Conditions []metav1.Condition `json:"conditions"`
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
 
 	// Devfile corresponds to the devfile representation of the Application resource
-	Devfile string `json:"devfile,omitempty"`
+	Devfile string `json:"devfile,omitempty" protobuf:"bytes,2,opt,name=devfile"`
+
+	// ComponentsReady is the number of Components of the Application which are ready: onboarded for all their
+	// versions and without failures. See SetApplicationHealth.
+	// Both counters are 0 until the health of the Application has been computed.
+	ComponentsReady int32 `json:"componentsReady" protobuf:"varint,3,opt,name=componentsReady"`
+
+	// ComponentsTotal is the number of Components of the Application.
+	ComponentsTotal int32 `json:"componentsTotal" protobuf:"varint,4,opt,name=componentsTotal"`
 }
 
 //+kubebuilder:object:root=true
@@ -90,12 +102,14 @@ type ApplicationStatus struct {
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[-1].status"
 // +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[-1].reason"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.componentsReady"
+// +kubebuilder:printcolumn:name="Components",type="integer",JSONPath=".status.componentsTotal"
 type Application struct {
 	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Spec   ApplicationSpec   `json:"spec"`
-	Status ApplicationStatus `json:"status,omitempty"`
+	Spec   ApplicationSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status ApplicationStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 //+kubebuilder:object:root=true
@@ -103,8 +117,8 @@ type Application struct {
 // ApplicationList contains a list of Application
 type ApplicationList struct {
 	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []Application `json:"items"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []Application `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
 func init() {
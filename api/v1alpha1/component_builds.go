@@ -0,0 +1,92 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComponentVersionBuild is a build of a version of a Component, as recorded by SetVersionBuild
+// +kubebuilder:object:generate=false
+// +protobuf=false
+type ComponentVersionBuild struct {
+	// Commit is the built commit id
+	Commit string
+
+	// Image is the built image, pinned to its digest
+	Image string
+
+	// PipelineRun is the name of the PipelineRun of the build
+	PipelineRun string
+}
+
+// VersionStatus returns the status of a version, matched by sanitized name, or nil if the version has no status.
+func (c *Component) VersionStatus(name string) *ComponentVersionStatus {
+	name = SanitizeVersionName(name)
+	for i := range c.Status.Versions {
+		if SanitizeVersionName(c.Status.Versions[i].Name) == name {
+			return &c.Status.Versions[i]
+		}
+	}
+	return nil
+}
+
+// DefaultVersionStatus returns the status of the default version, which is the first version of the spec,
+// or nil if the Component has no versions or the default version has no status yet.
+func (c *Component) DefaultVersionStatus() *ComponentVersionStatus {
+	if len(c.Spec.Source.Versions) == 0 {
+		return nil
+	}
+	return c.VersionStatus(c.Spec.Source.Versions[0].Name)
+}
+
+// SetVersionBuild records a build, which happened at buildTime, in the status of a version, then updates the
+// component-wide build status from the default version, see SyncDefaultVersionBuild.
+// An error is returned if the version has no status.
+func (c *Component) SetVersionBuild(name string, build ComponentVersionBuild, buildTime time.Time) error {
+	status := c.VersionStatus(name)
+	if status == nil {
+		return fmt.Errorf(VersionStatusNotFound, name)
+	}
+	status.LastBuiltCommit = build.Commit
+	status.LastBuiltImage = build.Image
+	status.LastPipelineRun = build.PipelineRun
+	// The time is serialized with a precision of a second
+	timestamp := metav1.NewTime(buildTime.UTC().Truncate(time.Second))
+	status.LastBuildTimestamp = &timestamp
+	c.SyncDefaultVersionBuild()
+	return nil
+}
+
+// SyncDefaultVersionBuild sets the component-wide LastBuiltCommit and LastPromotedImage, kept for compatibility,
+// to the last build of the default version. They are left unchanged when the default version was not built yet,
+// and for components without versions.
+func (c *Component) SyncDefaultVersionBuild() {
+	status := c.DefaultVersionStatus()
+	if status == nil {
+		return
+	}
+	if status.LastBuiltCommit != "" {
+		c.Status.LastBuiltCommit = status.LastBuiltCommit
+	}
+	if status.LastBuiltImage != "" {
+		c.Status.LastPromotedImage = status.LastBuiltImage
+	}
+}
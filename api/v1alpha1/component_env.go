@@ -0,0 +1,140 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"path"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ComponentEnvOptions holds the allow-lists of the Secrets and ConfigMaps that the environment variables of
+// a Component may reference. Entries are names or path.Match patterns, such as "myapp-*".
+// Nothing may be referenced when an allow-list is empty.
+// +kubebuilder:object:generate=false
+// +protobuf=false
+type ComponentEnvOptions struct {
+	// AllowedSecrets are the Secrets which may be referenced by valueFrom.secretKeyRef
+	AllowedSecrets []string
+
+	// AllowedConfigMaps are the ConfigMaps which may be referenced by valueFrom.configMapKeyRef
+	AllowedConfigMaps []string
+}
+
+// ValidateComponentEnv validates the environment variables of a Component: names must be set, valid and unique,
+// and each variable either has a value or takes it from a key of an allowed Secret or ConfigMap.
+// Other sources of valueFrom, such as fieldRef, are rejected.
+func ValidateComponentEnv(component *Component, options ComponentEnvOptions) error {
+	var errs field.ErrorList
+	envPath := field.NewPath("spec", "env")
+	names := map[string]bool{}
+	for i, env := range component.Spec.Env {
+		path := envPath.Index(i)
+		namePath := path.Child("name")
+		switch {
+		case env.Name == "":
+			errs = append(errs, field.Required(namePath, ""))
+		case names[env.Name]:
+			errs = append(errs, field.Duplicate(namePath, env.Name))
+		default:
+			for _, msg := range validation.IsEnvVarName(env.Name) {
+				errs = append(errs, field.Invalid(namePath, env.Name, msg))
+			}
+		}
+		names[env.Name] = true
+
+		if env.ValueFrom == nil {
+			continue
+		}
+		valueFromPath := path.Child("valueFrom")
+		if env.Value != "" {
+			errs = append(errs, field.Forbidden(path.Child("value"), EnvValueAndValueFromError))
+		}
+		source := env.ValueFrom
+		switch {
+		case source.FieldRef != nil:
+			errs = append(errs, field.Forbidden(valueFromPath.Child("fieldRef"), EnvValueFromNotSupported))
+		case source.ResourceFieldRef != nil:
+			errs = append(errs, field.Forbidden(valueFromPath.Child("resourceFieldRef"), EnvValueFromNotSupported))
+		case source.SecretKeyRef != nil && source.ConfigMapKeyRef != nil:
+			errs = append(errs, field.Invalid(valueFromPath, "", "only one of secretKeyRef and configMapKeyRef may be set"))
+		case source.SecretKeyRef != nil:
+			errs = append(errs, validateEnvKeyRef(valueFromPath.Child("secretKeyRef"), "Secret",
+				source.SecretKeyRef.Name, source.SecretKeyRef.Key, options.AllowedSecrets)...)
+		case source.ConfigMapKeyRef != nil:
+			errs = append(errs, validateEnvKeyRef(valueFromPath.Child("configMapKeyRef"), "ConfigMap",
+				source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key, options.AllowedConfigMaps)...)
+		default:
+			errs = append(errs, field.Required(valueFromPath, EnvValueFromNotSupported))
+		}
+	}
+	return errs.ToAggregate()
+}
+
+// ComponentEnvSources returns the sorted names of the Secrets and ConfigMaps referenced by the environment
+// variables of a Component, for instance to watch them or to grant access to them.
+func ComponentEnvSources(component *Component) (secrets []string, configMaps []string) {
+	secretNames := map[string]bool{}
+	configMapNames := map[string]bool{}
+	for _, env := range component.Spec.Env {
+		if env.ValueFrom == nil {
+			continue
+		}
+		if ref := env.ValueFrom.SecretKeyRef; ref != nil && ref.Name != "" && !secretNames[ref.Name] {
+			secretNames[ref.Name] = true
+			secrets = append(secrets, ref.Name)
+		}
+		if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil && ref.Name != "" && !configMapNames[ref.Name] {
+			configMapNames[ref.Name] = true
+			configMaps = append(configMaps, ref.Name)
+		}
+	}
+	sort.Strings(secrets)
+	sort.Strings(configMaps)
+	return secrets, configMaps
+}
+
+// validateEnvKeyRef validates a reference to a key of a Secret or ConfigMap against its allow-list.
+func validateEnvKeyRef(refPath *field.Path, kind, name, key string, allowed []string) field.ErrorList {
+	var errs field.ErrorList
+	if name == "" {
+		errs = append(errs, field.Required(refPath.Child("name"), ""))
+	} else if !envSourceAllowed(name, allowed) {
+		errs = append(errs, field.Forbidden(refPath.Child("name"), fmt.Sprintf(EnvSourceNotAllowedError, kind, name)))
+	}
+	if key == "" {
+		errs = append(errs, field.Required(refPath.Child("key"), ""))
+	} else {
+		for _, msg := range validation.IsConfigMapKey(key) {
+			errs = append(errs, field.Invalid(refPath.Child("key"), key, msg))
+		}
+	}
+	return errs
+}
+
+// envSourceAllowed returns true if the name matches an entry of the allow-list.
+func envSourceAllowed(name string, allowed []string) bool {
+	for _, pattern := range allowed {
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OnboardingTimeFormat is the layout of ComponentVersionStatus.OnboardingTime, as in "29 May 2024 15:11:16 UTC"
+const OnboardingTimeFormat = "02 Jan 2006 15:04:05 MST"
+
+// FormatOnboardingTime formats a time as ComponentVersionStatus.OnboardingTime, in UTC.
+func FormatOnboardingTime(t time.Time) string {
+	return t.UTC().Format(OnboardingTimeFormat)
+}
+
+// ParseOnboardingTime parses a ComponentVersionStatus.OnboardingTime value.
+// Only the UTC zone is reliably parsed: other zone abbreviations are parsed as UTC unless they are known to the local time zone.
+func ParseOnboardingTime(value string) (metav1.Time, error) {
+	t, err := time.Parse(OnboardingTimeFormat, value)
+	if err != nil {
+		return metav1.Time{}, err
+	}
+	return metav1.NewTime(t.UTC()), nil
+}
+
+// Onboarding returns the typed onboarding status of the version, either ComponentOnboardingSucceeded,
+// ComponentOnboardingFailed or empty if the version was not onboarded yet.
+func (s *ComponentVersionStatus) Onboarding() ComponentOnboardingStatus {
+	return ComponentOnboardingStatus(s.OnboardingStatus)
+}
+
+// SetOnboarded records the onboarding of the version: the status, and on success the onboarding time in both
+// the OnboardingTimestamp and legacy OnboardingTime fields. On failure both times are cleared.
+func (s *ComponentVersionStatus) SetOnboarded(status ComponentOnboardingStatus, onboardingTime time.Time) {
+	s.OnboardingStatus = string(status)
+	if status != ComponentOnboardingSucceeded {
+		s.OnboardingTime = ""
+		s.OnboardingTimestamp = nil
+		return
+	}
+	// The legacy format has a precision of a second
+	timestamp := metav1.NewTime(onboardingTime.UTC().Truncate(time.Second))
+	s.OnboardingTimestamp = &timestamp
+	s.OnboardingTime = FormatOnboardingTime(timestamp.Time)
+}
+
+// OnboardedAt returns when the version was onboarded, or nil if it was not onboarded successfully.
+// OnboardingTimestamp is used when set, otherwise the legacy OnboardingTime is parsed.
+func (s *ComponentVersionStatus) OnboardedAt() (*metav1.Time, error) {
+	if s.OnboardingTimestamp != nil {
+		return s.OnboardingTimestamp.DeepCopy(), nil
+	}
+	if s.OnboardingTime == "" {
+		return nil, nil
+	}
+	timestamp, err := ParseOnboardingTime(s.OnboardingTime)
+	if err != nil {
+		return nil, fmt.Errorf(InvalidOnboardingTime, s.OnboardingTime, s.Name, OnboardingTimeFormat)
+	}
+	return &timestamp, nil
+}
+
+// OnboardingAge returns how long ago the version was onboarded, relative to now.
+// The second return value is false if the version was not onboarded successfully.
+func (s *ComponentVersionStatus) OnboardingAge(now time.Time) (time.Duration, bool, error) {
+	onboardedAt, err := s.OnboardedAt()
+	if err != nil || onboardedAt == nil {
+		return 0, false, err
+	}
+	return now.Sub(onboardedAt.Time), true, nil
+}
+
+// MigrateOnboardingTime sets OnboardingTimestamp from the legacy OnboardingTime when only the latter is set.
+func (s *ComponentVersionStatus) MigrateOnboardingTime() error {
+	if s.OnboardingTimestamp != nil || s.OnboardingTime == "" {
+		return nil
+	}
+	onboardedAt, err := s.OnboardedAt()
+	if err != nil {
+		return err
+	}
+	s.OnboardingTimestamp = onboardedAt
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// HasGitSource returns whether the source is a git repository, given by 'git' or 'url'
+func (s *ComponentSource) HasGitSource() bool {
+	return s.GitSource != nil || s.GitURL != ""
+}
+
+// HasImageSource returns whether the source is a container image built outside of the Application
+func (s *ComponentSource) HasImageSource() bool {
+	return s.ImageSource != nil
+}
+
+// ValidateComponentSource checks that a Component has either a git source or an image source, but not both, and
+// that its image source is valid. The CRD only rejects components with both sources, so that existing components
+// without any source can still be updated.
+func ValidateComponentSource(component *Component) error {
+	var errs field.ErrorList
+	source := &component.Spec.Source
+	sourcePath := field.NewPath("spec", "source")
+
+	switch {
+	case source.HasGitSource() && source.HasImageSource():
+		errs = append(errs, field.Forbidden(sourcePath.Child("image"), GitAndImageSourceError))
+	case !source.HasGitSource() && !source.HasImageSource():
+		errs = append(errs, field.Required(sourcePath, MissingGitOrImageSource))
+	}
+	if source.HasImageSource() {
+		errs = append(errs, validateImageSource(source.ImageSource, sourcePath.Child("image"))...)
+	}
+
+	return errs.ToAggregate()
+}
+
+func validateImageSource(image *ImageSource, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	imagePath := path.Child("image")
+	switch {
+	case image.Image == "":
+		errs = append(errs, field.Required(imagePath, ""))
+	case strings.ContainsAny(image.Image, " \t\n"):
+		errs = append(errs, field.Invalid(imagePath, image.Image, "must not contain whitespace"))
+	}
+
+	switch image.Policy {
+	case "", ImagePolicyTag:
+	case ImagePolicyDigest:
+		if !strings.Contains(image.Image, "@sha256:") {
+			errs = append(errs, field.Invalid(imagePath, image.Image, ImageNotPinnedError))
+		}
+	default:
+		errs = append(errs, field.NotSupported(path.Child("policy"), image.Policy,
+			[]string{string(ImagePolicyTag), string(ImagePolicyDigest)}))
+	}
+	return errs
+}
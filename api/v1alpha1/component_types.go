@@ -24,31 +24,31 @@ import (
 // !!! Will be removed when we remove old model
 type GitSource struct {
 	// An HTTPS URL representing the git repository to create the component from.
-	URL string `json:"url"`
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
 
 	// Specify a branch/tag/commit id. If not specified, default is `main`/`master`.
 	// Example: devel.
 	// Optional.
-	Revision string `json:"revision,omitempty"`
+	Revision string `json:"revision,omitempty" protobuf:"bytes,2,opt,name=revision"`
 
 	// A relative path inside the git repo containing the component
 	// Example: folderA/folderB/gitops.
 	// Optional.
-	Context string `json:"context,omitempty"`
+	Context string `json:"context,omitempty" protobuf:"bytes,3,opt,name=context"`
 
 	// If specified, the devfile at the URI will be used for the component. Can be a local path inside the repository, or an external URL.
 	// Example: https://raw.githubusercontent.com/devfile-samples/devfile-sample-java-springboot-basic/main/devfile.yaml.
 	// Optional.
-	DevfileURL string `json:"devfileUrl,omitempty"`
+	DevfileURL string `json:"devfileUrl,omitempty" protobuf:"bytes,4,opt,name=devfileUrl"`
 
 	// If specified, the dockerfile at the URI will be used for the component. Can be a local path inside the repository, or an external URL.
 	// Optional.
-	DockerfileURL string `json:"dockerfileUrl,omitempty"`
+	DockerfileURL string `json:"dockerfileUrl,omitempty" protobuf:"bytes,5,opt,name=dockerfileUrl"`
 }
 
 // ComponentSource describes the Component source
 type ComponentSource struct {
-	ComponentSourceUnion `json:",inline"`
+	ComponentSourceUnion `json:",inline" protobuf:"bytes,1,opt,name=componentSourceUnion"`
 }
 
 // +union
@@ -56,58 +56,101 @@ type ComponentSourceUnion struct {
 	// Git Source for a Component.
 	// Optional.
 	// !!! Will be removed when we remove old model
-	GitSource *GitSource `json:"git,omitempty"`
+	GitSource *GitSource `json:"git,omitempty" protobuf:"bytes,1,opt,name=git"`
 
 	// Git repository URL for the component.
 	// Optional.
 	// !!! Will be required when we remove old model
-	GitURL string `json:"url,omitempty"`
+	GitURL string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
 
 	// Dockerfile path for all versions, unless explicitly specified for a version.
 	// Used only when sending a PR with build pipeline configuration was requested via 'spec.actions.create-pipeline-configuration-pr'.
 	// Default: "Dockerfile"
 	// Optional.
-	DockerfileURI string `json:"dockerfileUri,omitempty"`
+	DockerfileURI string `json:"dockerfileUri,omitempty" protobuf:"bytes,3,opt,name=dockerfileUri"`
 
 	// List of all versions for this component.
 	// Optional.
 	// !!! Will be required when we remove old model
-	Versions []ComponentVersion `json:"versions,omitempty"`
+	// +listType=map
+	// +listMapKey=name
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	Versions []ComponentVersion `json:"versions,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,4,rep,name=versions"`
+
+	// Image Source for a Component which is not built from a git repository, such as a pre-built third-party image.
+	// Cannot be specified together with 'git' or 'url'.
+	// Optional.
+	// +optional
+	ImageSource *ImageSource `json:"image,omitempty" protobuf:"bytes,5,opt,name=image"`
 }
 
+// ImageSource describes a Component whose container image is built outside of the Application
+type ImageSource struct {
+	// Container image reference of the Component.
+	// Example: quay.io/org/image:v1.2 or quay.io/org/image@sha256:<digest>.
+	// Required.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image" protobuf:"bytes,1,opt,name=image"`
+
+	// Name of a Kubernetes secret, in the namespace of the Component, holding the credentials to pull the image.
+	// Optional.
+	// +optional
+	PullSecret string `json:"pullSecret,omitempty" protobuf:"bytes,2,opt,name=pullSecret"`
+
+	// Policy selecting the image of the Component in Snapshots:
+	// Tag resolves the tag of the image reference to its current digest,
+	// Digest uses the image reference as is, which must then be pinned to a digest (see ValidateComponentSource).
+	// Default: Tag
+	// Optional.
+	// +optional
+	// +kubebuilder:default=Tag
+	Policy ImagePolicy `json:"policy,omitempty" protobuf:"bytes,3,opt,name=policy,casttype=ImagePolicy"`
+}
+
+// ImagePolicy selects how the image of an image-source Component is resolved
+// +kubebuilder:validation:Enum=Tag;Digest
+type ImagePolicy string
+
+const (
+	ImagePolicyTag    ImagePolicy = "Tag"
+	ImagePolicyDigest ImagePolicy = "Digest"
+)
+
 type ComponentActions struct {
 	// Send a PR with build pipeline configuration proposal for Component version(s).
 	// If not set, version onboarding will be done without pipeline configuration PR.
 	// Could be used after onboarding to create / renew build pipeline definition.
 	// Optional.
-	CreateConfiguration ComponentCreatePipelineConfiguration `json:"create-pipeline-configuration-pr,omitempty"`
+	CreateConfiguration ComponentCreatePipelineConfiguration `json:"create-pipeline-configuration-pr,omitempty" protobuf:"bytes,1,opt,name=createPipelineConfigurationPr"`
 
 	// Specify name of component version to restart the push build for.
 	// Can be specified together with 'trigger-push-builds' and any duplicates will be removed.
 	// Optional.
-	TriggerBuild string `json:"trigger-push-build,omitempty"`
+	TriggerBuild string `json:"trigger-push-build,omitempty" protobuf:"bytes,2,opt,name=triggerPushBuild"`
 
 	// Specify names of component versions to restart the push build for.
 	// Can be specified together with 'trigger-push-build' and any duplicates will be removed.
 	// Optional.
-	TriggerBuilds []string `json:"trigger-push-builds,omitempty"`
+	TriggerBuilds []string `json:"trigger-push-builds,omitempty" protobuf:"bytes,3,rep,name=triggerPushBuilds"`
 }
 
 type ComponentCreatePipelineConfiguration struct {
 	// When specified it will send a PR with build pipeline configuration proposal for all Component versions.
 	// Has precedence over 'version' and 'versions'.
 	// Optional.
-	AllVersions bool `json:"all-versions,omitempty"`
+	AllVersions bool `json:"all-versions,omitempty" protobuf:"varint,1,opt,name=allVersions"`
 
 	// When specified it will send a PR with build pipeline configuration proposal for the Component version.
 	// Can be specified together with 'versions' and any duplicates will be removed.
 	// Optional.
-	Version string `json:"version,omitempty"`
+	Version string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
 
 	// When specified it will send a PR with build pipeline configuration proposal for Component versions.
 	// Can be specified together with 'version' and any duplicates will be removed.
 	// Optional.
-	Versions []string `json:"versions,omitempty"`
+	Versions []string `json:"versions,omitempty" protobuf:"bytes,3,rep,name=versions"`
 }
 
 type ComponentBuildPipeline struct {
@@ -116,21 +159,21 @@ type ComponentBuildPipeline struct {
 	// Optional.
 	// +optional
 	// +nullable
-	PullAndPush *PipelineDefinition `json:"pull-and-push,omitempty"`
+	PullAndPush *PipelineDefinition `json:"pull-and-push,omitempty" protobuf:"bytes,1,opt,name=pullAndPush"`
 
 	// Pipeline used for pull pipeline run.
 	// Can specify just one of: pipelinespec-from-bundle, pipelineref-by-name, pipelineref-by-git-resolver.
 	// Optional.
 	// +optional
 	// +nullable
-	Pull *PipelineDefinition `json:"pull,omitempty"`
+	Pull *PipelineDefinition `json:"pull,omitempty" protobuf:"bytes,2,opt,name=pull"`
 
 	// Pipeline used for push pipeline run.
 	// Can specify just one of: pipelinespec-from-bundle, pipelineref-by-name, pipelineref-by-git-resolver.
 	// Optional.
 	// +optional
 	// +nullable
-	Push *PipelineDefinition `json:"push,omitempty"`
+	Push *PipelineDefinition `json:"push,omitempty" protobuf:"bytes,3,opt,name=push"`
 }
 
 type PipelineDefinition struct {
@@ -139,13 +182,13 @@ type PipelineDefinition struct {
 	// Optional.
 	// +optional
 	// +nullable
-	PipelineRefGit *PipelineRefGit `json:"pipelineref-by-git-resolver,omitempty"`
+	PipelineRefGit *PipelineRefGit `json:"pipelineref-by-git-resolver,omitempty" protobuf:"bytes,1,opt,name=pipelinerefByGitResolver"`
 
 	// Will be used to fill out PipelineRef in pipeline runs to user specific pipeline.
 	// Such pipeline definition has to be in .tekton.
 	// Optional.
 	// +optional
-	PipelineRefName string `json:"pipelineref-by-name,omitempty"`
+	PipelineRefName string `json:"pipelineref-by-name,omitempty" protobuf:"bytes,2,opt,name=pipelinerefByName"`
 
 	// Will be used to fetch bundle and fill out PipelineSpec in pipeline runs.
 	// Pipeline name is based on build-pipeline-config CM in build-service NS.
@@ -155,7 +198,7 @@ type PipelineDefinition struct {
 	// Optional.
 	// +optional
 	// +nullable
-	PipelineSpecFromBundle *PipelineSpecFromBundle `json:"pipelinespec-from-bundle,omitempty"`
+	PipelineSpecFromBundle *PipelineSpecFromBundle `json:"pipelinespec-from-bundle,omitempty" protobuf:"bytes,3,opt,name=pipelinespecFromBundle"`
 }
 
 type PipelineSpecFromBundle struct {
@@ -163,12 +206,12 @@ type PipelineSpecFromBundle struct {
 	// or specify a specific bundle image.
 	// Required.
 	// +required
-	Bundle string `json:"bundle"`
+	Bundle string `json:"bundle" protobuf:"bytes,1,opt,name=bundle"`
 
 	// Pipeline name to fetch from the bundle, or from build-pipeline-config CM.
 	// Required.
 	// +required
-	Name string `json:"name"`
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
 }
 
 type PipelineRefGit struct {
@@ -176,19 +219,19 @@ type PipelineRefGit struct {
 	// Example: pipeline/push.yaml
 	// Required.
 	// +required
-	PathInRepo string `json:"pathInRepo"`
+	PathInRepo string `json:"pathInRepo" protobuf:"bytes,1,opt,name=pathInRepo"`
 
 	// Git revision (branch, tag, or commit) to use.
 	// Example: main
 	// Required.
 	// +required
-	Revision string `json:"revision"`
+	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 
 	// Git repository URL containing the pipeline definition.
 	// Example: https://github.com/custom-pipelines/pipelines.git
 	// Required.
 	// +required
-	Url string `json:"url"`
+	Url string `json:"url" protobuf:"bytes,3,opt,name=url"`
 }
 
 type ComponentVersion struct {
@@ -197,45 +240,62 @@ type ComponentVersion struct {
 	// Optional.
 	// +optional
 	// +nullable
-	BuildPipeline *ComponentBuildPipeline `json:"build-pipeline,omitempty"`
+	BuildPipeline *ComponentBuildPipeline `json:"build-pipeline,omitempty" protobuf:"bytes,1,opt,name=buildPipeline"`
 
 	// Context directory for the version.
 	// Used only when sending a PR with build pipeline configuration was requested via 'spec.actions.create-pipeline-configuration-pr'.
 	// Default: "" (empty string, root of repository).
 	// Optional.
-	Context string `json:"context,omitempty"`
+	Context string `json:"context,omitempty" protobuf:"bytes,2,opt,name=context"`
 
 	// Dockerfile path for the version.
 	// Used only when sending a PR with build pipeline configuration was requested via 'spec.actions.create-pipeline-configuration-pr'.
 	// Default: "Dockerfile".
 	// Optional.
-	DockerfileURI string `json:"dockerfileUri,omitempty"`
+	DockerfileURI string `json:"dockerfileUri,omitempty" protobuf:"bytes,3,opt,name=dockerfileUri"`
 
 	// User defined name for the version.
 	// After sanitization (lower case, removing spaces, etc) all version names must be unique.
 	// Required.
 	// +required
-	Name string `json:"name"`
+	Name string `json:"name" protobuf:"bytes,4,opt,name=name"`
 
 	// Git branch to use for the version.
 	// Required.
 	// +required
-	Revision string `json:"revision"`
+	Revision string `json:"revision" protobuf:"bytes,5,opt,name=revision"`
 
 	// When 'true' it will disable builds for a revision in the version.
 	// Default: false.
 	// Optional.
-	SkipBuilds bool `json:"skip-builds,omitempty"`
+	SkipBuilds bool `json:"skip-builds,omitempty" protobuf:"varint,6,opt,name=skipBuilds"`
+
+	// Glob patterns of the paths, relative to the root of the repository, whose changes trigger builds of the version.
+	// A pattern also matches the paths under the directories it matches, and '**' matches any number of directories.
+	// Default: the context directory of the version, or the whole repository when it has none.
+	// Example: ["backend", "go.mod", "**/*.proto"].
+	// Optional.
+	// +optional
+	// +listType=set
+	IncludePaths []string `json:"include-paths,omitempty" protobuf:"bytes,7,rep,name=includePaths"`
+
+	// Glob patterns of the paths, relative to the root of the repository, whose changes do not trigger builds
+	// of the version, even when matched by 'include-paths'.
+	// Example: ["**/*.md", "docs"].
+	// Optional.
+	// +optional
+	// +listType=set
+	ExcludePaths []string `json:"exclude-paths,omitempty" protobuf:"bytes,8,rep,name=excludePaths"`
 }
 
 type RepositorySettings struct {
 	// When specified, will set value of `comment_strategy` in the Repository CR
 	// Optional.
-	CommentStrategy string `json:"comment-strategy,omitempty"`
+	CommentStrategy string `json:"comment-strategy,omitempty" protobuf:"bytes,1,opt,name=commentStrategy"`
 
 	// When specified, will add values to `github_app_token_scope_repos` in the Repository CR
 	// Optional.
-	GithubAppTokenScopeRepos []string `json:"github-app-token-scope-repos,omitempty"`
+	GithubAppTokenScopeRepos []string `json:"github-app-token-scope-repos,omitempty" protobuf:"bytes,2,rep,name=githubAppTokenScopeRepos"`
 }
 
 // ComponentSpec defines the desired state of Component
@@ -247,14 +307,14 @@ type ComponentSpec struct {
 	// Optional.
 	// +optional
 	// !!! Will be removed when we remove old model
-	ComponentName string `json:"componentName,omitempty"`
+	ComponentName string `json:"componentName,omitempty" protobuf:"bytes,1,opt,name=componentName"`
 
 	// +kubebuilder:validation:Pattern=^$|^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
 	// Application is the name of the application resource that the component belongs to.
 	// Optional.
 	// +optional
 	// !!! Will be removed when we remove old model
-	Application string `json:"application,omitempty"`
+	Application string `json:"application,omitempty" protobuf:"bytes,2,opt,name=application"`
 
 	// Secret describes the name of a Kubernetes secret containing either:
 	// 1. A Personal Access Token to access the Component's git repostiory (if using a Git-source component) or
@@ -262,40 +322,47 @@ type ComponentSpec struct {
 	// Optional.
 	// +optional
 	// !!! Will be removed when we remove old model
-	Secret string `json:"secret,omitempty"`
+	Secret string `json:"secret,omitempty" protobuf:"bytes,3,opt,name=secret"`
 
 	// Source describes the Component source.
 	// Required.
 	// +required
-	Source ComponentSource `json:"source"`
+	// +kubebuilder:validation:XValidation:rule="!has(self.image) || !has(self.git) && !has(self.url)",message="a component cannot have both a git source and an image source"
+	Source ComponentSource `json:"source" protobuf:"bytes,4,opt,name=source"`
 
 	// Compute Resources required by this component.
 	// Optional.
 	// +optional
-	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	Resources corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,5,opt,name=resources"`
 
 	// The number of replicas to deploy the component with.
 	// Optional.
 	// +optional
 	// !!! Will be removed when we remove old model
-	Replicas *int `json:"replicas,omitempty"`
+	Replicas *int `json:"replicas,omitempty" protobuf:"varint,6,opt,name=replicas,casttype=int"`
 
 	// The port to expose the component over.
 	// Optional.
 	// +optional
 	// !!! Will be removed when we remove old model
-	TargetPort int `json:"targetPort,omitempty"`
+	TargetPort int `json:"targetPort,omitempty" protobuf:"varint,7,opt,name=targetPort,casttype=int"`
 
 	// The route to expose the component with.
 	// Optional.
 	// +optional
 	// !!! Will be removed when we remove old model
-	Route string `json:"route,omitempty"`
+	Route string `json:"route,omitempty" protobuf:"bytes,8,opt,name=route"`
 
-	// An array of environment variables to add to the component (ValueFrom not currently supported)
+	// An array of environment variables to add to the component.
+	// Names must be unique. ValueFrom only supports secretKeyRef and configMapKeyRef, and the referenced
+	// Secrets and ConfigMaps must be allowed by the service consuming the component (see ValidateComponentEnv).
 	// Optional
 	// +optional
-	Env []corev1.EnvVar `json:"env,omitempty"`
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:items:XValidation:rule="!has(self.valueFrom) || !has(self.valueFrom.fieldRef) && !has(self.valueFrom.resourceFieldRef)",message="only secretKeyRef and configMapKeyRef are supported"
+	// +kubebuilder:validation:items:XValidation:rule="!has(self.valueFrom) || !has(self.value) || self.value == ''",message="value and valueFrom cannot both be set"
+	Env []corev1.EnvVar `json:"env,omitempty" protobuf:"bytes,9,rep,name=env"`
 
 	// The container image repository to use for this component (without tag).
 	// Either will be set by Image Repository, or explicitly specified with custom repo.
@@ -303,36 +370,36 @@ type ComponentSpec struct {
 	// Example: quay.io/org/tenant/component
 	// Optional.
 	// +optional
-	ContainerImage string `json:"containerImage,omitempty"`
+	ContainerImage string `json:"containerImage,omitempty" protobuf:"bytes,10,opt,name=containerImage"`
 
 	// Whether or not to bypass the generation of GitOps resources for the Component. Defaults to false.
 	// Optional.
 	// +optional
 	// !!! Will be removed when we remove old model
-	SkipGitOpsResourceGeneration bool `json:"skipGitOpsResourceGeneration,omitempty"`
+	SkipGitOpsResourceGeneration bool `json:"skipGitOpsResourceGeneration,omitempty" protobuf:"varint,11,opt,name=skipGitOpsResourceGeneration"`
 
 	// The list of components to be nudged by this components build upon a successful result.
 	// Optional.
 	// +optional
 	// !!! Will be removed when we remove old model
-	BuildNudgesRef []string `json:"build-nudges-ref,omitempty"`
+	BuildNudgesRef []string `json:"build-nudges-ref,omitempty" protobuf:"bytes,12,rep,name=buildNudgesRef"`
 
 	// Specific actions that will be processed by the controller and then removed from 'spec.actions'.
 	// Used for triggering builds or creating pipeline configuration PRs.
 	// Optional.
 	// +optional
-	Actions ComponentActions `json:"actions,omitempty"`
+	Actions ComponentActions `json:"actions,omitempty" protobuf:"bytes,13,opt,name=actions"`
 
 	// When 'true', during offboarding a cleaning PR won't be created.
 	// Default: false.
 	// Optional.
 	// +optional
-	SkipOffboardingPr bool `json:"skip-offboarding-pr,omitempty"`
+	SkipOffboardingPr bool `json:"skip-offboarding-pr,omitempty" protobuf:"varint,14,opt,name=skipOffboardingPr"`
 
 	// Used for setting additional settings for the Repository CR.
 	// Optional.
 	// +optional
-	RepositorySettings RepositorySettings `json:"repository-settings,omitempty"`
+	RepositorySettings RepositorySettings `json:"repository-settings,omitempty" protobuf:"bytes,15,opt,name=repositorySettings"`
 
 	// Used only when sending a PR with build pipeline configuration was requested via 'spec.actions.create-pipeline-configuration-pr'.
 	// Pipeline used for all versions, unless explicitly specified for a specific version.
@@ -340,7 +407,7 @@ type ComponentSpec struct {
 	// Optional.
 	// +optional
 	// +nullable
-	DefaultBuildPipeline *ComponentBuildPipeline `json:"default-build-pipeline,omitempty"`
+	DefaultBuildPipeline *ComponentBuildPipeline `json:"default-build-pipeline,omitempty" protobuf:"bytes,16,opt,name=defaultBuildPipeline"`
 }
 
 // ComponentStatus defines the observed state of Component
@@ -349,94 +416,145 @@ type ComponentStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 
 	// Conditions is an array of the Component's status conditions
-	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
 
 	// Webhook URL generated by Builds
 	// !!! Will be removed when we remove old model
-	Webhook string `json:"webhook,omitempty"`
+	Webhook string `json:"webhook,omitempty" protobuf:"bytes,2,opt,name=webhook"`
 
 	// The devfile model for the Component CR
 	// !!! Will be removed when we remove old model
-	Devfile string `json:"devfile,omitempty"`
+	Devfile string `json:"devfile,omitempty" protobuf:"bytes,3,opt,name=devfile"`
 
 	// GitOps specific status for the Component CR
 	// !!! Will be removed when we remove old model
-	GitOps GitOpsStatus `json:"gitops,omitempty"`
+	GitOps GitOpsStatus `json:"gitops,omitempty" protobuf:"bytes,4,opt,name=gitops"`
 
 	// The last built commit id (SHA-1 checksum) from the latest component build.
+	// For components with versions, it is the last built commit of the default version (see versions[].last-built-commit).
 	// Example: 41fbdb124775323f58fd5ce93c70bb7d79c20650.
-	// !!! Will be removed when we remove old model SHOULD this be in version specific section??
-	LastBuiltCommit string `json:"lastBuiltCommit,omitempty"`
+	// !!! Will be removed when we remove old model
+	LastBuiltCommit string `json:"lastBuiltCommit,omitempty" protobuf:"bytes,5,opt,name=lastBuiltCommit"`
 
 	// The last digest image component promoted with.
+	// For components with versions, it is the last built image of the default version (see versions[].last-built-image).
 	// Example: quay.io/someorg/somerepository@sha256:5ca85b7f7b9da18a9c4101e81ee1d9bac35ac2b0b0221908ff7389204660a262.
-	// !!! Will be removed when we remove old model SHOULD this be in version specific section??
-	LastPromotedImage string `json:"lastPromotedImage,omitempty"`
+	// !!! Will be removed when we remove old model
+	LastPromotedImage string `json:"lastPromotedImage,omitempty" protobuf:"bytes,6,opt,name=lastPromotedImage"`
 
 	// The list of names of Components whose builds nudge this resource (their spec.build-nudges-ref[] references this component)
 	// !!! Will be removed when we remove old model
-	BuildNudgedBy []string `json:"build-nudged-by,omitempty"`
+	BuildNudgedBy []string `json:"build-nudged-by,omitempty" protobuf:"bytes,7,rep,name=buildNudgedBy"`
 
 	// Identifies which additional settings are used for the Repository CR.
-	RepositorySettings RepositorySettings `json:"repository-settings,omitempty"`
+	RepositorySettings RepositorySettings `json:"repository-settings,omitempty" protobuf:"bytes,8,opt,name=repositorySettings"`
 
 	// General error message, not specific to any version (version-specific errors are in versions[].message).
 	// Example: "Spec.ContainerImage is not set" or "GitHub App is not installed".
-	Message string `json:"message,omitempty"`
+	Message string `json:"message,omitempty" protobuf:"bytes,9,opt,name=message"`
 
 	// Name of Repository CR for the component.
-	PacRepository string `json:"pac-repository,omitempty"`
+	PacRepository string `json:"pac-repository,omitempty" protobuf:"bytes,10,opt,name=pacRepository"`
 
 	// All versions which were processed by onboarding.
 	// When version is removed from the spec, offboarding will remove it from the status.
-	Versions []ComponentVersionStatus `json:"versions,omitempty"`
+	// +listType=map
+	// +listMapKey=name
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	Versions []ComponentVersionStatus `json:"versions,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,11,rep,name=versions"`
 }
 
 // GitOpsStatus contains GitOps repository-specific status for the component
 // !!! Will be removed when we remove old model
 type GitOpsStatus struct {
 	// RepositoryURL is the gitops repository URL for the component
-	RepositoryURL string `json:"repositoryURL,omitempty"`
+	RepositoryURL string `json:"repositoryURL,omitempty" protobuf:"bytes,1,opt,name=repositoryURL"`
 
 	// Branch is the git branch used for the gitops repository
-	Branch string `json:"branch,omitempty"`
+	Branch string `json:"branch,omitempty" protobuf:"bytes,2,opt,name=branch"`
 
 	// Context is the path within the gitops repository used for the gitops resources
-	Context string `json:"context,omitempty"`
+	Context string `json:"context,omitempty" protobuf:"bytes,3,opt,name=context"`
 
 	// ResourceGenerationSkipped is whether or not GitOps resource generation was skipped for the component
-	ResourceGenerationSkipped bool `json:"resourceGenerationSkipped,omitempty"`
+	ResourceGenerationSkipped bool `json:"resourceGenerationSkipped,omitempty" protobuf:"varint,4,opt,name=resourceGenerationSkipped"`
 
 	// CommitID is the most recent commit ID in the GitOps repository for this component
-	CommitID string `json:"commitID,omitempty"`
+	CommitID string `json:"commitID,omitempty" protobuf:"bytes,5,opt,name=commitID"`
 }
 
+// ComponentOnboardingStatus is the result of the onboarding of a version, the typed value of
+// ComponentVersionStatus.OnboardingStatus (see ComponentVersionStatus.Onboarding)
+type ComponentOnboardingStatus string
+
+const (
+	ComponentOnboardingSucceeded ComponentOnboardingStatus = "succeeded"
+	ComponentOnboardingFailed    ComponentOnboardingStatus = "failed"
+)
+
 type ComponentVersionStatus struct {
 	// Link with onboarding PR if requested by 'spec.actions.create-pipeline-configuration-pr'.
 	// Only present if onboarding was successful.
 	// Example: https://github.com/user/repo/pull/1
-	ConfigurationMergeURL string `json:"configuration-merge-url,omitempty"`
+	ConfigurationMergeURL string `json:"configuration-merge-url,omitempty" protobuf:"bytes,1,opt,name=configurationMergeUrl"`
 
 	// Version specific error message.
 	// Example: "pipeline for this version doesn't exist"
-	Message string `json:"message,omitempty"`
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
 
-	// Name for the version.
-	Name string `json:"name,omitempty"`
+	// Name for the version, as in the spec of the Component.
+	// Required.
+	// +required
+	Name string `json:"name" protobuf:"bytes,3,opt,name=name"`
 
 	// Onboarding status will be either 'succeeded' or 'failed' ('disabled' won't be there because we will just remove specific version section).
-	OnboardingStatus string `json:"onboarding-status,omitempty"`
+	OnboardingStatus string `json:"onboarding-status,omitempty" protobuf:"bytes,4,opt,name=onboardingStatus"`
 
-	// Timestamp for when onboarding happened.
+	// Timestamp for when onboarding happened, in the OnboardingTimeFormat format.
 	// Only present if onboarding was successful.
+	// Kept for compatibility, OnboardingTimestamp should be used instead.
 	// Example: "29 May 2024 15:11:16 UTC"
-	OnboardingTime string `json:"onboarding-time,omitempty"`
+	OnboardingTime string `json:"onboarding-time,omitempty" protobuf:"bytes,5,opt,name=onboardingTime"`
+
+	// Timestamp for when onboarding happened, the same as OnboardingTime in a sortable format.
+	// Only present if onboarding was successful.
+	// Example: "2024-05-29T15:11:16Z"
+	// +optional
+	OnboardingTimestamp *metav1.Time `json:"onboarding-timestamp,omitempty" protobuf:"bytes,6,opt,name=onboardingTimestamp"`
 
 	// Git revision (branch) for the version.
-	Revision string `json:"revision,omitempty"`
+	Revision string `json:"revision,omitempty" protobuf:"bytes,7,opt,name=revision"`
 
 	// Identifies that builds for the revision in the version are disabled.
-	SkipBuilds bool `json:"skip-builds,omitempty"`
+	SkipBuilds bool `json:"skip-builds,omitempty" protobuf:"varint,8,opt,name=skipBuilds"`
+
+	// The last built commit id (SHA-1 checksum) from the latest build of the version.
+	// Example: 41fbdb124775323f58fd5ce93c70bb7d79c20650.
+	// Optional.
+	// +optional
+	LastBuiltCommit string `json:"last-built-commit,omitempty" protobuf:"bytes,9,opt,name=lastBuiltCommit"`
+
+	// The image built by the latest build of the version, pinned to its digest.
+	// Example: quay.io/someorg/somerepository@sha256:5ca85b7f7b9da18a9c4101e81ee1d9bac35ac2b0b0221908ff7389204660a262.
+	// Optional.
+	// +optional
+	LastBuiltImage string `json:"last-built-image,omitempty" protobuf:"bytes,10,opt,name=lastBuiltImage"`
+
+	// Name of the PipelineRun of the latest build of the version.
+	// Optional.
+	// +optional
+	LastPipelineRun string `json:"last-pipeline-run,omitempty" protobuf:"bytes,11,opt,name=lastPipelineRun"`
+
+	// Timestamp for when the latest build of the version happened.
+	// Example: "2024-05-29T15:11:16Z"
+	// Optional.
+	// +optional
+	LastBuildTimestamp *metav1.Time `json:"last-build-timestamp,omitempty" protobuf:"bytes,12,opt,name=lastBuildTimestamp"`
 }
 
 //+kubebuilder:object:root=true
@@ -450,10 +568,10 @@ type ComponentVersionStatus struct {
 // +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".status.conditions[-1].type"
 type Component struct {
 	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Spec   ComponentSpec   `json:"spec"`
-	Status ComponentStatus `json:"status,omitempty"`
+	Spec   ComponentSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status ComponentStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 //+kubebuilder:object:root=true
@@ -461,8 +579,8 @@ type Component struct {
 // ComponentList contains a list of Component
 type ComponentList struct {
 	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []Component `json:"items"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []Component `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
 func init() {
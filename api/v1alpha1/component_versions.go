@@ -0,0 +1,135 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// invalidVersionNameChars matches the runs of characters replaced by '-' when sanitizing a version name
+var invalidVersionNameChars = regexp.MustCompile(`[^a-z0-9._]+`)
+
+// ComponentVersionPlan is the difference between the versions in the spec and in the status of a Component,
+// which is what onboarding and offboarding will act on.
+// +kubebuilder:object:generate=false
+// +protobuf=false
+type ComponentVersionPlan struct {
+	// Onboard are the versions of the spec which are not in the status yet, in spec order
+	Onboard []ComponentVersion
+
+	// Offboard are the versions of the status which were removed from the spec, in status order
+	Offboard []ComponentVersionStatus
+
+	// Update are the versions whose revision or SkipBuilds changed in the spec, in spec order
+	Update []ComponentVersionUpdate
+
+	// Unchanged are the versions of the spec which match their status, in spec order
+	Unchanged []ComponentVersion
+
+	// Duplicate are the entries of the status repeating the sanitized name of an earlier entry, in status order.
+	// They should be removed from the status, without offboarding: the version is onboarded or offboarded
+	// according to its first entry.
+	Duplicate []ComponentVersionStatus
+}
+
+// ComponentVersionUpdate is a version whose spec differs from its status
+// +kubebuilder:object:generate=false
+// +protobuf=false
+type ComponentVersionUpdate struct {
+	// Version is the version in the spec
+	Version ComponentVersion
+
+	// Status is the current status of the version
+	Status ComponentVersionStatus
+
+	// RevisionChanged is true when the git revision of the version changed
+	RevisionChanged bool
+
+	// SkipBuildsChanged is true when builds of the version were enabled or disabled
+	SkipBuildsChanged bool
+}
+
+// HasChanges returns true if any version has to be onboarded, offboarded or updated, or if the status has
+// duplicate entries to remove.
+func (p *ComponentVersionPlan) HasChanges() bool {
+	return len(p.Onboard) > 0 || len(p.Offboard) > 0 || len(p.Update) > 0 || len(p.Duplicate) > 0
+}
+
+// SanitizeVersionName returns the name identifying a version once sanitized: lower case, with surrounding
+// spaces removed and any run of characters other than alphanumeric characters, '.' and '_' replaced by '-'.
+// An empty string is returned if name has no usable characters.
+func SanitizeVersionName(name string) string {
+	name = invalidVersionNameChars.ReplaceAllString(strings.ToLower(strings.TrimSpace(name)), "-")
+	return strings.Trim(name, "-")
+}
+
+// PlanComponentVersions compares the versions in the spec of the Component with the versions in its status,
+// matching them by sanitized name, and returns the resulting plan.
+// An error is returned if the name of a version in the spec is empty after sanitization, or if two versions
+// of the spec have the same sanitized name.
+func PlanComponentVersions(component *Component) (*ComponentVersionPlan, error) {
+	specNames := map[string]string{}
+	for _, version := range component.Spec.Source.Versions {
+		name := SanitizeVersionName(version.Name)
+		if name == "" {
+			return nil, fmt.Errorf(InvalidVersionNameError, version.Name)
+		}
+		if other, found := specNames[name]; found {
+			return nil, fmt.Errorf(DuplicateVersionNameError, other, version.Name, name)
+		}
+		specNames[name] = version.Name
+	}
+
+	statuses := map[string]ComponentVersionStatus{}
+	seen := map[string]bool{}
+	plan := &ComponentVersionPlan{}
+	for _, status := range component.Status.Versions {
+		name := SanitizeVersionName(status.Name)
+		if seen[name] {
+			plan.Duplicate = append(plan.Duplicate, status)
+			continue
+		}
+		seen[name] = true
+		if _, inSpec := specNames[name]; !inSpec {
+			plan.Offboard = append(plan.Offboard, status)
+			continue
+		}
+		statuses[name] = status
+	}
+
+	for _, version := range component.Spec.Source.Versions {
+		status, found := statuses[SanitizeVersionName(version.Name)]
+		if !found {
+			plan.Onboard = append(plan.Onboard, version)
+			continue
+		}
+		update := ComponentVersionUpdate{
+			Version:           version,
+			Status:            status,
+			RevisionChanged:   version.Revision != status.Revision,
+			SkipBuildsChanged: version.SkipBuilds != status.SkipBuilds,
+		}
+		if update.RevisionChanged || update.SkipBuildsChanged {
+			plan.Update = append(plan.Update, update)
+			continue
+		}
+		plan.Unchanged = append(plan.Unchanged, version)
+	}
+	return plan, nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// componentNameMaxLength is the maximum length of a component name, see ComponentSpec.ComponentName
+	componentNameMaxLength = 63
+
+	// componentNameSuffixLength is the length of the random suffix appended when GenerateComponentName is set
+	componentNameSuffixLength = 4
+
+	// defaultComponentRevision is the revision used when neither the detected component nor the query specify one
+	defaultComponentRevision = "main"
+
+	componentNameSuffixCharset = "abcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+var invalidComponentNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// ComponentsFromDetectionOptions configures how detected components are turned into Components.
+// +kubebuilder:object:generate=false
+// +protobuf=false
+type ComponentsFromDetectionOptions struct {
+	// Application is the name of the Application the Components will belong to.
+	Application string
+
+	// Seed is used to generate the random suffix of the component names when the query has GenerateComponentName set,
+	// so that the same query always produces the same names. When nil, the suffixes are random.
+	Seed *int64
+}
+
+// ComponentsFromDetectionQuery turns the components detected by a ComponentDetectionQuery into Components
+// that are ready to be created in the query's namespace. The Components are sorted by name.
+//
+// Each Component is built from the detected ComponentStub: the name is derived from the detected component name
+// (with a random suffix when the query has GenerateComponentName set), and the Application, the git URL,
+// the proposed versions (or a version for the detected revision) and the query's Secret are filled in
+// when the stub does not set them. Stubs with an image source are not given a git source or versions.
+func ComponentsFromDetectionQuery(cdq *ComponentDetectionQuery, options ComponentsFromDetectionOptions) ([]Component, error) {
+	seed := time.Now().UnixNano()
+	if options.Seed != nil {
+		seed = *options.Seed
+	}
+	random := rand.New(rand.NewSource(seed)) // #nosec G404 -- component name suffixes do not need to be unpredictable
+
+	detectedNames := make([]string, 0, len(cdq.Status.ComponentDetected))
+	for detectedName := range cdq.Status.ComponentDetected {
+		detectedNames = append(detectedNames, detectedName)
+	}
+	sort.Strings(detectedNames)
+
+	components := make([]Component, 0, len(detectedNames))
+	usedNames := map[string]bool{}
+	for _, detectedName := range detectedNames {
+		description := cdq.Status.ComponentDetected[detectedName]
+
+		baseName := description.ComponentStub.ComponentName
+		if baseName == "" {
+			baseName = detectedName
+		}
+		name := SanitizeComponentName(baseName)
+		if name == "" {
+			return nil, fmt.Errorf(InvalidDNS1035Name, baseName)
+		}
+		if cdq.Spec.GenerateComponentName {
+			name = AppendComponentNameSuffix(name, randomComponentNameSuffix(random))
+		}
+		for i, uniqueName := 2, name; usedNames[name]; i++ {
+			name = AppendComponentNameSuffix(uniqueName, strconv.Itoa(i))
+		}
+		usedNames[name] = true
+
+		components = append(components, componentFromDescription(cdq, name, description, options.Application))
+	}
+
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].Name < components[j].Name
+	})
+	return components, nil
+}
+
+// componentFromDescription builds a Component named name from the ComponentStub and the proposed versions of a detected component.
+func componentFromDescription(cdq *ComponentDetectionQuery, name string, description ComponentDetectionDescription, application string) Component {
+	component := Component{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "Component",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cdq.Namespace,
+		},
+		Spec: *description.ComponentStub.DeepCopy(),
+	}
+	spec := &component.Spec
+
+	spec.ComponentName = name
+	if application != "" {
+		spec.Application = application
+	}
+	if spec.Secret == "" {
+		spec.Secret = cdq.Spec.Secret
+	}
+	if spec.Source.HasImageSource() {
+		// A component cannot have both a git source and an image source
+		return component
+	}
+
+	gitSource := spec.Source.GitSource
+	if gitSource == nil {
+		gitSource = cdq.Spec.GitSource.DeepCopy()
+		spec.Source.GitSource = gitSource
+	}
+	if gitSource.URL == "" {
+		gitSource.URL = cdq.Spec.GitSource.URL
+	}
+	if gitSource.Revision == "" {
+		gitSource.Revision = cdq.Spec.GitSource.Revision
+	}
+
+	if spec.Source.GitURL == "" {
+		spec.Source.GitURL = gitSource.URL
+	}
+	if len(spec.Source.Versions) == 0 && len(description.ProposedVersions) > 0 {
+		for _, version := range description.ProposedVersions {
+			spec.Source.Versions = append(spec.Source.Versions, *version.DeepCopy())
+		}
+	}
+	if len(spec.Source.Versions) == 0 {
+		revision := gitSource.Revision
+		if revision == "" {
+			revision = defaultComponentRevision
+		}
+		spec.Source.Versions = []ComponentVersion{{
+			Name:          revision,
+			Revision:      revision,
+			Context:       gitSource.Context,
+			DockerfileURI: gitSource.DockerfileURL,
+		}}
+	}
+
+	return component
+}
+
+// SanitizeComponentName turns name into a valid component name: lower case alphanumeric characters or '-',
+// starting with an alphabetical character, ending with an alphanumeric character and at most 63 characters long.
+// An empty string is returned if name has no usable characters.
+func SanitizeComponentName(name string) string {
+	name = invalidComponentNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	name = strings.TrimLeft(name, "-0123456789")
+	if len(name) > componentNameMaxLength {
+		name = name[:componentNameMaxLength]
+	}
+	return strings.TrimRight(name, "-")
+}
+
+// AppendComponentNameSuffix appends "-suffix" to name, shortening name if needed to stay within the maximum length
+// of a component name.
+func AppendComponentNameSuffix(name, suffix string) string {
+	maxBaseLength := componentNameMaxLength - len(suffix) - 1
+	if len(name) > maxBaseLength {
+		name = strings.TrimRight(name[:maxBaseLength], "-")
+	}
+	return name + "-" + suffix
+}
+
+// randomComponentNameSuffix returns a random suffix of lower case alphanumeric characters.
+func randomComponentNameSuffix(random *rand.Rand) string {
+	suffix := make([]byte, componentNameSuffixLength)
+	for i := range suffix {
+		suffix[i] = componentNameSuffixCharset[random.Intn(len(componentNameSuffixCharset))]
+	}
+	return string(suffix)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+)
+
+func TestComponentsFromDetectionQuerySources(t *testing.T) {
+	cdq := &v1alpha1.ComponentDetectionQuery{
+		Spec: v1alpha1.ComponentDetectionQuerySpec{
+			GitSource: v1alpha1.GitSource{URL: "https://github.com/org/repo", Revision: "devel"},
+		},
+		Status: v1alpha1.ComponentDetectionQueryStatus{
+			ComponentDetected: v1alpha1.ComponentDetectionMap{
+				"git": {ComponentStub: v1alpha1.ComponentSpec{}},
+				"image": {ComponentStub: v1alpha1.ComponentSpec{
+					Source: v1alpha1.ComponentSource{ComponentSourceUnion: v1alpha1.ComponentSourceUnion{
+						ImageSource: &v1alpha1.ImageSource{Image: "quay.io/org/image:v1"},
+					}},
+				}},
+			},
+		},
+	}
+	components, err := v1alpha1.ComponentsFromDetectionQuery(cdq, v1alpha1.ComponentsFromDetectionOptions{Application: "app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(components) != 2 {
+		t.Fatalf("got %d components, want 2", len(components))
+	}
+	for i := range components {
+		component := &components[i]
+		if err := v1alpha1.ValidateComponentSource(component); err != nil {
+			t.Errorf("component %s: %v", component.Name, err)
+		}
+		source := component.Spec.Source
+		switch component.Name {
+		case "git":
+			if source.GitURL != cdq.Spec.GitSource.URL || source.GitSource == nil || len(source.Versions) != 1 || source.Versions[0].Revision != "devel" {
+				t.Errorf("component git: unexpected source %+v", source)
+			}
+		case "image":
+			if source.HasGitSource() || len(source.Versions) != 0 {
+				t.Errorf("component image: unexpected git source or versions %+v", source)
+			}
+		}
+	}
+}
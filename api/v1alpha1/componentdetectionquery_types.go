@@ -29,37 +29,52 @@ type ComponentDetectionQuerySpec struct {
 	// Git Source for a Component.
 	// Required.
 	// +required
-	GitSource GitSource `json:"git"`
+	GitSource GitSource `json:"git" protobuf:"bytes,1,opt,name=git"`
+
+	// Revisions lists additional git branches to detect components in, besides the revision of the git source.
+	// Components found in several revisions are returned once, with a proposed version for each revision.
+	// Example: ["release-1.0", "release-2.0"].
+	// Optional.
+	// +optional
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,2,rep,name=revisions"`
 
 	// Secret describes the name of an optional Kubernetes secret containing a Personal Access Token to access the git repostiory.
 	// Optional.
 	// +optional
-	Secret string `json:"secret,omitempty"`
+	Secret string `json:"secret,omitempty" protobuf:"bytes,3,opt,name=secret"`
 
 	// It defines if should generate random characters at the end of the component name instead of a predicted default value
 	// The default value is false.
 	// If the value is set to true, component name will always have random characters appended
 	// Optional.
 	// +optional
-	GenerateComponentName bool `json:"generateComponentName,omitempty"`
+	GenerateComponentName bool `json:"generateComponentName,omitempty" protobuf:"varint,4,opt,name=generateComponentName"`
 }
 
 // ComponentDetectionDescription holds all the information about the component being detected
 type ComponentDetectionDescription struct {
 
 	// DevfileFound tells if a devfile is found in the component
-	DevfileFound bool `json:"devfileFound,omitempty"`
+	DevfileFound bool `json:"devfileFound,omitempty" protobuf:"varint,1,opt,name=devfileFound"`
 
 	// Language specifies the language of the component detected
 	// Example: JavaScript
-	Language string `json:"language,omitempty"`
+	Language string `json:"language,omitempty" protobuf:"bytes,2,opt,name=language"`
 
 	// ProjectType specifies the type of project for the component detected
 	// Example Node.JS
-	ProjectType string `json:"projectType,omitempty"`
+	ProjectType string `json:"projectType,omitempty" protobuf:"bytes,3,opt,name=projectType"`
 
 	// ComponentStub is a stub of the component detected with all the info gathered from the devfile or service detection
-	ComponentStub ComponentSpec `json:"componentStub,omitempty"`
+	ComponentStub ComponentSpec `json:"componentStub,omitempty" protobuf:"bytes,4,opt,name=componentStub"`
+
+	// ProposedVersions are the component versions proposed for the revisions the component was detected in,
+	// with the context directory and Dockerfile found in each revision.
+	// Optional.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ProposedVersions []ComponentVersion `json:"proposedVersions,omitempty" protobuf:"bytes,5,rep,name=proposedVersions"`
 }
 
 // ComponentDetectionMap is a map containing all the components and their detected information
@@ -71,10 +86,14 @@ type ComponentDetectionQueryStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 
 	// Conditions is an array of the ComponentDetectionQuery's status conditions
-	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
 
 	// ComponentDetected gives a list of components and the info from detection
-	ComponentDetected ComponentDetectionMap `json:"componentDetected,omitempty"`
+	ComponentDetected ComponentDetectionMap `json:"componentDetected,omitempty" protobuf:"bytes,2,rep,name=componentDetected,casttype=ComponentDetectionMap"`
 }
 
 //+kubebuilder:object:root=true
@@ -88,10 +107,10 @@ type ComponentDetectionQueryStatus struct {
 // +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".status.conditions[-1].type"
 type ComponentDetectionQuery struct {
 	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Spec   ComponentDetectionQuerySpec   `json:"spec"`
-	Status ComponentDetectionQueryStatus `json:"status,omitempty"`
+	Spec   ComponentDetectionQuerySpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status ComponentDetectionQueryStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 //+kubebuilder:object:root=true
@@ -99,8 +118,8 @@ type ComponentDetectionQuery struct {
 // ComponentDetectionQueryList contains a list of ComponentDetectionQuery
 type ComponentDetectionQueryList struct {
 	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []ComponentDetectionQuery `json:"items"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ComponentDetectionQuery `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
 func init() {
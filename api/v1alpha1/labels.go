@@ -0,0 +1,180 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Well-known labels tying objects, such as Components, Snapshots and PipelineRuns, back to the Application model
+const (
+	// ApplicationLabel is the name of the Application an object belongs to
+	ApplicationLabel = "appstudio.openshift.io/application"
+
+	// ComponentLabel is the name of the Component an object belongs to
+	ComponentLabel = "appstudio.openshift.io/component"
+
+	// ComponentVersionLabel is the sanitized name of the Component version an object belongs to, see SanitizeVersionName
+	ComponentVersionLabel = "appstudio.openshift.io/version"
+
+	// SnapshotLabel is the name of the Snapshot an object was created for, such as a test PipelineRun
+	SnapshotLabel = "appstudio.openshift.io/snapshot"
+)
+
+// Well-known annotations, see also SnapshotContentHashAnnotation
+const (
+	// BuildRequestAnnotation requests an action from the build service on a Component.
+	// The build service removes it once the request has been processed.
+	BuildRequestAnnotation = "build.appstudio.openshift.io/request"
+
+	// Values of BuildRequestAnnotation
+	BuildRequestTriggerPaCBuild = "trigger-pac-build"
+	BuildRequestConfigurePaC    = "configure-pac"
+	BuildRequestUnconfigurePaC  = "unconfigure-pac"
+)
+
+// Names of objects may be longer than label values, or not valid label values at all, such as names of up to
+// 253 characters or version names starting with '.'. The helpers below return an error for such values and leave
+// the object unchanged, rather than labeling it with values which the API server would reject.
+
+// SetApplicationLabel labels an object with the name of its Application.
+func SetApplicationLabel(object metav1.Object, application string) error {
+	return setLabels(object, ApplicationLabel, application)
+}
+
+// SetComponentLabels labels an object with the name of a Component and of its Application, if any.
+func SetComponentLabels(object metav1.Object, component *Component) error {
+	return setLabels(object, componentLabels(component)...)
+}
+
+// SetComponentVersionLabels labels an object with the names of a Component, of its Application and of one of its
+// versions, such as the PipelineRuns building the version.
+func SetComponentVersionLabels(object metav1.Object, component *Component, version string) error {
+	return setLabels(object, append(componentLabels(component), ComponentVersionLabel, SanitizeVersionName(version))...)
+}
+
+// SetSnapshotLabels labels an object with the name of a Snapshot and of its Application, if any.
+func SetSnapshotLabels(object metav1.Object, snapshot *Snapshot) error {
+	var keysAndValues []string
+	if snapshot.Spec.Application != "" {
+		keysAndValues = append(keysAndValues, ApplicationLabel, snapshot.Spec.Application)
+	}
+	return setLabels(object, append(keysAndValues, SnapshotLabel, snapshot.Name)...)
+}
+
+// SetComponentOwner makes the Application the controller owner of the Component, and labels the Component with it.
+// The Component must be in the namespace of the Application and must not belong to another Application,
+// or be controlled by another object.
+func SetComponentOwner(application *Application, component *Component) error {
+	if component.Spec.Application != "" && component.Spec.Application != application.Name {
+		return fmt.Errorf(OwnerApplicationMismatchError, "Component", component.Name, component.Spec.Application, application.Name)
+	}
+	if err := validateLabelValue(ApplicationLabel, application.Name); err != nil {
+		return err
+	}
+	if err := setApplicationControllerReference(application, component, "Component"); err != nil {
+		return err
+	}
+	return SetApplicationLabel(component, application.Name)
+}
+
+// SetSnapshotOwner makes the Application the controller owner of the Snapshot, and labels the Snapshot with it.
+// The Snapshot must be in the namespace of the Application and must not belong to another Application,
+// or be controlled by another object.
+func SetSnapshotOwner(application *Application, snapshot *Snapshot) error {
+	if snapshot.Spec.Application != "" && snapshot.Spec.Application != application.Name {
+		return fmt.Errorf(OwnerApplicationMismatchError, "Snapshot", snapshot.Name, snapshot.Spec.Application, application.Name)
+	}
+	if err := validateLabelValue(ApplicationLabel, application.Name); err != nil {
+		return err
+	}
+	if err := setApplicationControllerReference(application, snapshot, "Snapshot"); err != nil {
+		return err
+	}
+	return SetApplicationLabel(snapshot, application.Name)
+}
+
+// IsControlledByApplication returns true if the Application is the controller owner of the object.
+func IsControlledByApplication(object metav1.Object, application *Application) bool {
+	controller := metav1.GetControllerOf(object)
+	return controller != nil && controller.UID == application.UID && controller.UID != ""
+}
+
+// setApplicationControllerReference sets the Application as the controller owner reference of an object,
+// replacing an existing reference to the Application.
+func setApplicationControllerReference(application *Application, object metav1.Object, kind string) error {
+	if application.UID == "" {
+		return fmt.Errorf(OwnerWithoutUIDError, "Application", application.Name)
+	}
+	if object.GetNamespace() != application.Namespace {
+		return fmt.Errorf(CrossNamespaceOwnerError, kind, object.GetName(), object.GetNamespace(),
+			"Application", application.Name, application.Namespace)
+	}
+	if controller := metav1.GetControllerOf(object); controller != nil && controller.UID != application.UID {
+		return fmt.Errorf(AlreadyControlledError, kind, object.GetName(), controller.Kind, controller.Name)
+	}
+
+	reference := *metav1.NewControllerRef(application, GroupVersion.WithKind("Application"))
+	references := object.GetOwnerReferences()
+	for i := range references {
+		if references[i].UID == application.UID {
+			references[i] = reference
+			object.SetOwnerReferences(references)
+			return nil
+		}
+	}
+	object.SetOwnerReferences(append(references, reference))
+	return nil
+}
+
+// componentLabels returns the keys and values of the labels of a Component and of its Application, if any.
+func componentLabels(component *Component) []string {
+	var keysAndValues []string
+	if component.Spec.Application != "" {
+		keysAndValues = append(keysAndValues, ApplicationLabel, component.Spec.Application)
+	}
+	return append(keysAndValues, ComponentLabel, component.Name)
+}
+
+// setLabels sets labels given as alternating keys and values, once all the values are checked.
+func setLabels(object metav1.Object, keysAndValues ...string) error {
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if err := validateLabelValue(keysAndValues[i], keysAndValues[i+1]); err != nil {
+			return err
+		}
+	}
+	labels := object.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for i := 0; i < len(keysAndValues); i += 2 {
+		labels[keysAndValues[i]] = keysAndValues[i+1]
+	}
+	object.SetLabels(labels)
+	return nil
+}
+
+func validateLabelValue(key, value string) error {
+	if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+		return fmt.Errorf(InvalidLabelValueError, value, key, strings.Join(errs, "; "))
+	}
+	return nil
+}
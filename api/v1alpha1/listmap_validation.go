@@ -0,0 +1,104 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateListMapKeys checks that the keys of the map lists of an Application, Component, ComponentDetectionQuery
+// or Snapshot are unique: the names of the versions, including those of the detected components, the name and
+// version of the snapshot components and the types of the conditions. The API server enforces the same for the listMapKey markers of the CRDs, this lets clients
+// which do not go through it, such as the fake client, reject the same objects.
+func ValidateListMapKeys(object runtime.Object) error {
+	var errs field.ErrorList
+	switch o := object.(type) {
+	case *Application:
+		errs = validateConditionTypes(o.Status.Conditions, field.NewPath("status", "conditions"))
+	case *Component:
+		errs = validateUniqueKeys(field.NewPath("spec", "source", "versions"), "name", len(o.Spec.Source.Versions), func(i int) string {
+			return o.Spec.Source.Versions[i].Name
+		})
+		errs = append(errs, validateUniqueKeys(field.NewPath("status", "versions"), "name", len(o.Status.Versions), func(i int) string {
+			return o.Status.Versions[i].Name
+		})...)
+		errs = append(errs, validateConditionTypes(o.Status.Conditions, field.NewPath("status", "conditions"))...)
+	case *ComponentDetectionQuery:
+		errs = validateDetectedVersions(o.Status.ComponentDetected, field.NewPath("status", "componentDetected"))
+		errs = append(errs, validateConditionTypes(o.Status.Conditions, field.NewPath("status", "conditions"))...)
+	case *Snapshot:
+		components := o.Spec.Components
+		errs = validateUniqueKeys(field.NewPath("spec", "components"), "", len(components), func(i int) string {
+			return fmt.Sprintf(SnapshotComponentKey, components[i].Name, components[i].Version)
+		})
+		errs = append(errs, validateConditionTypes(o.Status.Conditions, field.NewPath("status", "conditions"))...)
+	}
+	return errs.ToAggregate()
+}
+
+func validateConditionTypes(conditions []metav1.Condition, path *field.Path) field.ErrorList {
+	return validateUniqueKeys(path, "type", len(conditions), func(i int) string {
+		return conditions[i].Type
+	})
+}
+
+// validateDetectedVersions checks the names of the versions of the stub and of the proposed versions of every
+// detected component, in the order of the component names.
+func validateDetectedVersions(detected ComponentDetectionMap, path *field.Path) field.ErrorList {
+	names := make([]string, 0, len(detected))
+	for name := range detected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs field.ErrorList
+	for _, name := range names {
+		description := detected[name]
+		versions := description.ComponentStub.Source.Versions
+		errs = append(errs, validateUniqueKeys(path.Key(name).Child("componentStub", "source", "versions"), "name", len(versions), func(i int) string {
+			return versions[i].Name
+		})...)
+		errs = append(errs, validateUniqueKeys(path.Key(name).Child("proposedVersions"), "name", len(description.ProposedVersions), func(i int) string {
+			return description.ProposedVersions[i].Name
+		})...)
+	}
+	return errs
+}
+
+// validateUniqueKeys returns a Duplicate error for every item of a list whose key is the key of a previous item.
+// The errors point to the key field of the item, or to the item itself when keyField is empty.
+func validateUniqueKeys(path *field.Path, keyField string, length int, key func(int) string) field.ErrorList {
+	var errs field.ErrorList
+	seen := make(map[string]bool, length)
+	for i := 0; i < length; i++ {
+		value := key(i)
+		if seen[value] {
+			itemPath := path.Index(i)
+			if keyField != "" {
+				itemPath = itemPath.Child(keyField)
+			}
+			errs = append(errs, field.Duplicate(itemPath, value))
+		}
+		seen[value] = true
+	}
+	return errs
+}
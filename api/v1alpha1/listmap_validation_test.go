@@ -0,0 +1,45 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+)
+
+func TestValidateListMapKeysComponentDetectionQuery(t *testing.T) {
+	versions := []v1alpha1.ComponentVersion{{Name: "main"}, {Name: "v1"}, {Name: "main"}}
+	cdq := &v1alpha1.ComponentDetectionQuery{
+		Status: v1alpha1.ComponentDetectionQueryStatus{
+			ComponentDetected: v1alpha1.ComponentDetectionMap{
+				"web": {ProposedVersions: versions},
+				"api": {ComponentStub: v1alpha1.ComponentSpec{
+					Source: v1alpha1.ComponentSource{ComponentSourceUnion: v1alpha1.ComponentSourceUnion{Versions: versions}},
+				}},
+				"db": {ProposedVersions: versions[:2]},
+			},
+		},
+	}
+
+	err := v1alpha1.ValidateListMapKeys(cdq)
+	want := `[status.componentDetected[api].componentStub.source.versions[2].name: Duplicate value: "main", ` +
+		`status.componentDetected[web].proposedVersions[2].name: Duplicate value: "main"]`
+	if err == nil || err.Error() != want {
+		t.Errorf("ValidateListMapKeys() = %v, want %s", err, want)
+	}
+}
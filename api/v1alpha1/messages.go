@@ -27,9 +27,48 @@ const (
 	MissingIngressDomain = "ingress domain cannot be empty if cluster is of type Kubernetes"
 
 	MissingGitOrImageSource = "a git source or an image source must be specified when creating a component"
+	GitAndImageSourceError  = "a component cannot have both a git source and an image source"
+	ImageNotPinnedError     = "the image must be pinned to a digest when the policy is Digest"
 
 	ComponentNameUpdateError   = "component name cannot be updated to %s"
 	ApplicationNameUpdateError = "application name cannot be updated to %s"
 	GitSourceUpdateError       = "git source cannot be updated to %+v"
 	InvalidComponentError      = "runtime object is not of type Component"
+
+	BuildNudgeSelfReferenceError = "a component cannot nudge itself"
+	BuildNudgeNotFoundError      = "component %s does not exist"
+	BuildNudgeApplicationError   = "component %s belongs to application %s, not to application %s"
+	BuildNudgeCycleError         = "build nudges would form a cycle: %s"
+
+	InvalidPathPatternError = "invalid path pattern: %v"
+
+	MissingSnapshotContentHash     = "snapshot %s does not have a content hash annotation"
+	SnapshotApplicationUpdateError = "snapshot application cannot be updated"
+	SnapshotComponentsUpdateError  = "snapshot components cannot be updated"
+	SnapshotComponentKey           = "component %s version %q"
+
+	InvalidUnstableSnapshotArtifacts = "unable to read %q from the unstable snapshot artifacts: %v"
+	ParentSnapshotCreatedMessage     = "snapshot %s was created for parent component group %s"
+	ParentSnapshotFailedMessage      = "snapshot for parent component group %s could not be created"
+
+	InvalidOnboardingTime = "onboarding time %q of version %s is not in the %q format"
+	VersionStatusNotFound = "version %s has no status"
+
+	InvalidVersionNameError   = "version name %q has no valid characters"
+	DuplicateVersionNameError = "versions %q and %q have the same sanitized name %s"
+
+	EnvValueAndValueFromError = "value and valueFrom cannot both be set"
+	EnvValueFromNotSupported  = "only secretKeyRef and configMapKeyRef are supported"
+	EnvSourceNotAllowedError  = "%s %s is not allowed as a source of environment variables"
+
+	ComponentsReadyMessage          = "%d of %d components are ready"
+	ComponentVersionOnboardingError = "version %s failed to onboard: %s"
+	ComponentVersionPendingMessage  = "version %s is not onboarded yet"
+	ComponentConditionFailedMessage = "condition %s is %s: %s"
+
+	AlreadyControlledError        = "%s %s is already controlled by %s %s"
+	CrossNamespaceOwnerError      = "%s %s in namespace %s cannot be owned by %s %s in namespace %s"
+	OwnerWithoutUIDError          = "%s %s has no UID and cannot own other objects"
+	OwnerApplicationMismatchError = "%s %s belongs to application %s, not to application %s"
+	InvalidLabelValueError        = "%q is not a valid value of label %s: %s"
 )
@@ -0,0 +1,28 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/application-api/pkg/apitesting"
+)
+
+func TestRoundTripTypes(t *testing.T) {
+	apitesting.RoundTripTypes(t, v1alpha1.AddToScheme, apitesting.Options{})
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// unstableImagesKey is the key of the image artifact links within SnapshotArtifacts.UnstableFields
+	unstableImagesKey = "images"
+
+	// unstableTestResultsKey is the key of the test results within SnapshotArtifacts.UnstableFields
+	unstableTestResultsKey = "testResults"
+)
+
+// ImageArtifacts returns the image artifact links of the Snapshot.
+// Links from the typed Images field take precedence. For the transition period, links stored under the
+// 'images' key of UnstableFields are also returned, unless the typed field already has a link for the same image.
+func (a *SnapshotArtifacts) ImageArtifacts() ([]SnapshotImageArtifact, error) {
+	images := append([]SnapshotImageArtifact{}, a.Images...)
+
+	var unstableImages []SnapshotImageArtifact
+	if err := a.readUnstableField(unstableImagesKey, &unstableImages); err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(images))
+	for _, image := range images {
+		known[image.ContainerImage] = true
+	}
+	for _, image := range unstableImages {
+		if !known[image.ContainerImage] {
+			known[image.ContainerImage] = true
+			images = append(images, image)
+		}
+	}
+	return images, nil
+}
+
+// ImageArtifact returns the artifact links of the given container image, or nil if the Snapshot has none.
+func (a *SnapshotArtifacts) ImageArtifact(containerImage string) (*SnapshotImageArtifact, error) {
+	images, err := a.ImageArtifacts()
+	if err != nil {
+		return nil, err
+	}
+	for i := range images {
+		if images[i].ContainerImage == containerImage {
+			return &images[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// TestResultArtifacts returns the test results of the Snapshot.
+// Results from the typed TestResults field take precedence. For the transition period, results stored under the
+// 'testResults' key of UnstableFields are also returned, unless the typed field already has a result for the same scenario.
+func (a *SnapshotArtifacts) TestResultArtifacts() ([]SnapshotTestResult, error) {
+	results := append([]SnapshotTestResult{}, a.TestResults...)
+
+	var unstableResults []SnapshotTestResult
+	if err := a.readUnstableField(unstableTestResultsKey, &unstableResults); err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(results))
+	for _, result := range results {
+		known[result.Scenario] = true
+	}
+	for _, result := range unstableResults {
+		if !known[result.Scenario] {
+			known[result.Scenario] = true
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// readUnstableField decodes the value stored under key in UnstableFields into out.
+// Nothing is decoded if UnstableFields is not set, is not a JSON object, or does not contain the key.
+func (a *SnapshotArtifacts) readUnstableField(key string, out interface{}) error {
+	if a.UnstableFields == nil || len(a.UnstableFields.Raw) == 0 {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(a.UnstableFields.Raw, &fields); err != nil {
+		// Experiments are free to store non-object data, which simply has no artifact links to offer
+		return nil
+	}
+	value, ok := fields[key]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(value, out); err != nil {
+		return fmt.Errorf(InvalidUnstableSnapshotArtifacts, key, err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"reflect"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+)
+
+func TestSnapshotArtifacts(t *testing.T) {
+	artifacts := &v1alpha1.SnapshotArtifacts{
+		Images: []v1alpha1.SnapshotImageArtifact{{ContainerImage: "quay.io/org/a"}},
+		TestResults: []v1alpha1.SnapshotTestResult{
+			{Scenario: "unit", Outcome: v1alpha1.SnapshotTestPassed},
+		},
+		UnstableFields: &apiextensionsv1.JSON{Raw: []byte(`{
+			"images": [{"containerImage": "quay.io/org/a"}, {"containerImage": "quay.io/org/b"}, {"containerImage": "quay.io/org/b"}],
+			"testResults": [
+				{"scenario": "unit", "outcome": "Failed"},
+				{"scenario": "e2e", "outcome": "Passed"},
+				{"scenario": "e2e", "outcome": "Failed"}
+			]
+		}`)},
+	}
+
+	images, err := artifacts.ImageArtifacts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var containerImages []string
+	for _, image := range images {
+		containerImages = append(containerImages, image.ContainerImage)
+	}
+	if want := []string{"quay.io/org/a", "quay.io/org/b"}; !reflect.DeepEqual(containerImages, want) {
+		t.Errorf("ImageArtifacts() = %v, want %v", containerImages, want)
+	}
+
+	results, err := artifacts.TestResultArtifacts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []v1alpha1.SnapshotTestResult{
+		{Scenario: "unit", Outcome: v1alpha1.SnapshotTestPassed},
+		{Scenario: "e2e", Outcome: v1alpha1.SnapshotTestPassed},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("TestResultArtifacts() = %+v, want %+v", results, want)
+	}
+}
+
+func TestSnapshotArtifactsInvalidUnstableFields(t *testing.T) {
+	artifacts := &v1alpha1.SnapshotArtifacts{
+		UnstableFields: &apiextensionsv1.JSON{Raw: []byte(`{"testResults": {"scenario": "unit"}}`)},
+	}
+	if _, err := artifacts.TestResultArtifacts(); err == nil {
+		t.Error("TestResultArtifacts() succeeded with a malformed testResults field")
+	}
+
+	artifacts.UnstableFields = &apiextensionsv1.JSON{Raw: []byte(`"not an object"`)}
+	if results, err := artifacts.TestResultArtifacts(); err != nil || len(results) != 0 {
+		t.Errorf("TestResultArtifacts() = %v, %v, want no results", results, err)
+	}
+}
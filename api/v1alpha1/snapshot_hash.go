@@ -0,0 +1,98 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+const (
+	// SnapshotContentHashAnnotation is the annotation holding the content hash of a Snapshot's spec,
+	// as computed by SnapshotSpecHash when the Snapshot was created.
+	SnapshotContentHashAnnotation = "appstudio.openshift.io/snapshot-content-hash"
+
+	// snapshotContentHashPrefix identifies the algorithm used for the content hash.
+	snapshotContentHashPrefix = "sha256:"
+)
+
+// CanonicalSnapshotSpec returns the canonical JSON serialization of the content of a SnapshotSpec: its
+// application, component group and components. The display fields are dropped, as are the artifacts, which are
+// recorded after the Snapshot is created, such as test results. The components are sorted, so two specs
+// describing the same content always serialize to the same bytes.
+func CanonicalSnapshotSpec(spec SnapshotSpec) ([]byte, error) {
+	canonical := spec.DeepCopy()
+	canonical.DisplayName = ""
+	canonical.DisplayDescription = ""
+	canonical.Artifacts = SnapshotArtifacts{}
+
+	sort.SliceStable(canonical.Components, func(i, j int) bool {
+		a, b := canonical.Components[i], canonical.Components[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+		return a.ContainerImage < b.ContainerImage
+	})
+
+	return json.Marshal(canonical)
+}
+
+// SnapshotSpecHash returns the content hash of a SnapshotSpec in the form "sha256:<hex digest>".
+// The hash is computed over CanonicalSnapshotSpec, so it does not change when the components are
+// reordered, when the display name or description are edited or when artifacts are recorded.
+func SnapshotSpecHash(spec SnapshotSpec) (string, error) {
+	canonical, err := CanonicalSnapshotSpec(spec)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return snapshotContentHashPrefix + hex.EncodeToString(sum[:]), nil
+}
+
+// SetSnapshotContentHash computes the content hash of the Snapshot's spec and stores it in the
+// SnapshotContentHashAnnotation annotation.
+func SetSnapshotContentHash(snapshot *Snapshot) error {
+	hash, err := SnapshotSpecHash(snapshot.Spec)
+	if err != nil {
+		return err
+	}
+	if snapshot.Annotations == nil {
+		snapshot.Annotations = map[string]string{}
+	}
+	snapshot.Annotations[SnapshotContentHashAnnotation] = hash
+	return nil
+}
+
+// VerifySnapshotContentHash reports whether the Snapshot's spec still matches the hash stored in the
+// SnapshotContentHashAnnotation annotation. An error is returned if the annotation is not set.
+func VerifySnapshotContentHash(snapshot *Snapshot) (bool, error) {
+	expected, ok := snapshot.Annotations[SnapshotContentHashAnnotation]
+	if !ok || expected == "" {
+		return false, fmt.Errorf(MissingSnapshotContentHash, snapshot.Name)
+	}
+	actual, err := SnapshotSpecHash(snapshot.Spec)
+	if err != nil {
+		return false, err
+	}
+	return actual == expected, nil
+}
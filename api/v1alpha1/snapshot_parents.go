@@ -0,0 +1,186 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ComponentGroupHierarchy maps the name of a ComponentGroup to the names of its parent ComponentGroups.
+// ComponentGroups without parents may be omitted.
+// +kubebuilder:object:generate=false
+type ComponentGroupHierarchy map[string][]string
+
+// Parents returns the sorted names of the direct parents of the given ComponentGroup.
+func (h ComponentGroupHierarchy) Parents(group string) []string {
+	seen := map[string]bool{group: true}
+	parents := []string{}
+	for _, parent := range h[group] {
+		if !seen[parent] {
+			seen[parent] = true
+			parents = append(parents, parent)
+		}
+	}
+	sort.Strings(parents)
+	return parents
+}
+
+// Ancestors returns the names of all the ancestors of the given ComponentGroup, nearest first.
+// Each ancestor is listed once, even if the hierarchy contains cycles.
+func (h ComponentGroupHierarchy) Ancestors(group string) []string {
+	return walkNames(group, h.Parents)
+}
+
+// Descendants returns the names of all the descendants of the given ComponentGroup, nearest first.
+// Each descendant is listed once, even if the hierarchy contains cycles.
+func (h ComponentGroupHierarchy) Descendants(group string) []string {
+	children := map[string][]string{}
+	for child := range h {
+		for _, parent := range h.Parents(child) {
+			children[parent] = append(children[parent], child)
+		}
+	}
+	return walkNames(group, func(parent string) []string {
+		sort.Strings(children[parent])
+		return children[parent]
+	})
+}
+
+// walkNames does a breadth-first walk from start, excluding start itself from the result.
+func walkNames(start string, next func(string) []string) []string {
+	visited := map[string]bool{start: true}
+	walked := []string{}
+	queue := []string{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, name := range next(current) {
+			if !visited[name] {
+				visited[name] = true
+				walked = append(walked, name)
+				queue = append(queue, name)
+			}
+		}
+	}
+	return walked
+}
+
+// ParentSnapshotsToCreate returns the sorted names of the parent ComponentGroups of the Snapshot's ComponentGroup
+// for which a parent snapshot has not been created yet, including those whose creation previously failed.
+func ParentSnapshotsToCreate(snapshot *Snapshot, hierarchy ComponentGroupHierarchy) []string {
+	if snapshot.Spec.ComponentGroup == "" {
+		return []string{}
+	}
+	toCreate := []string{}
+	for _, parent := range hierarchy.Parents(snapshot.Spec.ComponentGroup) {
+		if data, ok := snapshot.Status.ParentSnapshots[parent]; !ok || !data.Created {
+			toCreate = append(toCreate, parent)
+		}
+	}
+	return toCreate
+}
+
+// SetParentSnapshotCreated records in the Snapshot's status that the given parent snapshot was created for the parent ComponentGroup.
+func SetParentSnapshotCreated(snapshot *Snapshot, parentGroup, parentSnapshot string) {
+	if snapshot.Status.ParentSnapshots == nil {
+		snapshot.Status.ParentSnapshots = map[string]ParentSnapshotData{}
+	}
+	snapshot.Status.ParentSnapshots[parentGroup] = ParentSnapshotData{
+		Name:    parentSnapshot,
+		Created: true,
+		Message: fmt.Sprintf(ParentSnapshotCreatedMessage, parentSnapshot, parentGroup),
+	}
+}
+
+// SetParentSnapshotFailed records in the Snapshot's status that the parent snapshot for the parent ComponentGroup could not be created.
+// A parent snapshot that was already created is left untouched, as a later failed attempt does not remove it.
+// The message is taken from err, or is a generic one if err is nil.
+func SetParentSnapshotFailed(snapshot *Snapshot, parentGroup string, err error) {
+	if data, ok := snapshot.Status.ParentSnapshots[parentGroup]; ok && data.Created {
+		return
+	}
+	message := fmt.Sprintf(ParentSnapshotFailedMessage, parentGroup)
+	if err != nil {
+		message = err.Error()
+	}
+	if snapshot.Status.ParentSnapshots == nil {
+		snapshot.Status.ParentSnapshots = map[string]ParentSnapshotData{}
+	}
+	snapshot.Status.ParentSnapshots[parentGroup] = ParentSnapshotData{
+		Created: false,
+		Message: message,
+	}
+}
+
+// ParentSnapshotNames returns the sorted names of the parent snapshots created for the Snapshot.
+func ParentSnapshotNames(snapshot *Snapshot) []string {
+	names := []string{}
+	for _, data := range snapshot.Status.ParentSnapshots {
+		if data.Created && data.Name != "" {
+			names = append(names, data.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AncestorSnapshots returns the parent snapshots of the Snapshot, their own parent snapshots and so on, nearest first.
+// Parent snapshots are looked up by name in snapshots; the ones that are not found there are skipped.
+func AncestorSnapshots(snapshot *Snapshot, snapshots []Snapshot) []Snapshot {
+	byName := make(map[string]*Snapshot, len(snapshots))
+	for i := range snapshots {
+		byName[snapshots[i].Name] = &snapshots[i]
+	}
+	return walkSnapshots(snapshot.Name, byName, func(name string) []string {
+		if name == snapshot.Name {
+			return ParentSnapshotNames(snapshot)
+		}
+		if current, ok := byName[name]; ok {
+			return ParentSnapshotNames(current)
+		}
+		return nil
+	})
+}
+
+// DescendantSnapshots returns the snapshots in snapshots which have the Snapshot as parent snapshot, their own
+// child snapshots and so on, nearest first.
+func DescendantSnapshots(snapshot *Snapshot, snapshots []Snapshot) []Snapshot {
+	byName := make(map[string]*Snapshot, len(snapshots))
+	children := map[string][]string{}
+	for i := range snapshots {
+		byName[snapshots[i].Name] = &snapshots[i]
+		for _, parent := range ParentSnapshotNames(&snapshots[i]) {
+			children[parent] = append(children[parent], snapshots[i].Name)
+		}
+	}
+	return walkSnapshots(snapshot.Name, byName, func(name string) []string {
+		sort.Strings(children[name])
+		return children[name]
+	})
+}
+
+// walkSnapshots does a breadth-first walk from start, returning the snapshots that were reached, excluding start itself.
+func walkSnapshots(start string, byName map[string]*Snapshot, next func(string) []string) []Snapshot {
+	walked := []Snapshot{}
+	for _, name := range walkNames(start, next) {
+		if found, ok := byName[name]; ok {
+			walked = append(walked, *found)
+		}
+	}
+	return walked
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+)
+
+func TestSetParentSnapshotFailed(t *testing.T) {
+	snapshot := &v1alpha1.Snapshot{}
+
+	v1alpha1.SetParentSnapshotFailed(snapshot, "group", nil)
+	if got, want := snapshot.Status.ParentSnapshots["group"].Message, "snapshot for parent component group group could not be created"; got != want {
+		t.Errorf("message with a nil error = %q, want %q", got, want)
+	}
+
+	v1alpha1.SetParentSnapshotFailed(snapshot, "group", errors.New("quota exceeded"))
+	if got := snapshot.Status.ParentSnapshots["group"]; got.Created || got.Message != "quota exceeded" {
+		t.Errorf("parent snapshot = %+v, want a failure with the error message", got)
+	}
+
+	snapshot.Status.ParentSnapshots["group"] = v1alpha1.ParentSnapshotData{Name: "parent", Created: true}
+	v1alpha1.SetParentSnapshotFailed(snapshot, "group", errors.New("conflict"))
+	if got := snapshot.Status.ParentSnapshots["group"]; !got.Created || got.Name != "parent" {
+		t.Errorf("created parent snapshot was overwritten: %+v", got)
+	}
+}
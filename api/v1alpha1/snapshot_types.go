@@ -21,85 +21,224 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// The presence of the fields is compared with conditionals: API servers up to Kubernetes 1.24 estimate comparisons
+// of has() results above the cost budget of the rules, and would reject the CustomResourceDefinition.
+// +kubebuilder:validation:XValidation:rule="has(self.application) ? has(oldSelf.application) : !has(oldSelf.application)",message="snapshot application cannot be updated"
+// +kubebuilder:validation:XValidation:rule="has(self.components) ? has(oldSelf.components) : !has(oldSelf.components)",message="snapshot components cannot be updated"
+
 // SnapshotSpec defines the desired state of Snapshot
+// The application and components of a Snapshot cannot be changed once it has been created.
 type SnapshotSpec struct {
 
 	// Application is a reference to the name of an Application resource within the same namespace, which defines the target application for the Snapshot (when used with a Binding).
-	Application string `json:"application,omitempty"`
+	// Immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="snapshot application cannot be updated"
+	Application string `json:"application,omitempty" protobuf:"bytes,1,opt,name=application"`
 
 	// ComponentGroup is a reference to the name of a ComponentGroup resource within the same namespace, which defines the target ComponentGroup for the Snapshot.
-	ComponentGroup string `json:"componentGroup,omitempty"`
+	ComponentGroup string `json:"componentGroup,omitempty" protobuf:"bytes,2,opt,name=componentGroup"`
 
 	// DisplayName is a user-visible, user-definable name for the resource (and is not used for any functional behaviour)
-	DisplayName string `json:"displayName,omitempty"`
+	// Unlike the rest of the spec, it can be updated after the Snapshot has been created.
+	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,3,opt,name=displayName"`
 
 	// DisplayDescription is a user-visible, user definable description for the resource (and is not used for any functional behaviour)
-	DisplayDescription string `json:"displayDescription,omitempty"`
+	// Unlike the rest of the spec, it can be updated after the Snapshot has been created.
+	DisplayDescription string `json:"displayDescription,omitempty" protobuf:"bytes,4,opt,name=displayDescription"`
 
 	// Components field contains the sets of components to deploy as part of this snapshot.
-	Components []SnapshotComponent `json:"components,omitempty"`
+	// A component may appear once per version.
+	// Immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="snapshot components cannot be updated"
+	// +listType=map
+	// +listMapKey=name
+	// +listMapKey=version
+	Components []SnapshotComponent `json:"components,omitempty" protobuf:"bytes,5,rep,name=components"`
 
-	// Artifacts is a placeholder section for 'artifact links' we want to maintain to other AppStudio resources.
-	// See Environment API doc for details.
-	Artifacts SnapshotArtifacts `json:"artifacts,omitempty"`
+	// Artifacts contains the 'artifact links' we want to maintain to other AppStudio resources.
+	Artifacts SnapshotArtifacts `json:"artifacts,omitempty" protobuf:"bytes,6,opt,name=artifacts"`
 }
 
 // SnapshotComponent
 type SnapshotComponent struct {
 
 	// Name is the name of the component
-	Name string `json:"name"`
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 
 	// Version is the component verison.  Only required if multiple versions of the same
 	// Component are in the Snapshot
 	// +optional
-	Version string `json:"version,omitempty"`
+	// +kubebuilder:default=""
+	Version string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
 
 	// ContainerImage is the container image to use when deploying the component, as part of a Snapshot
-	ContainerImage string `json:"containerImage"`
+	ContainerImage string `json:"containerImage" protobuf:"bytes,3,opt,name=containerImage"`
 
 	// Source describes the Component source.
 	// Optional.
 	// +optional
-	Source ComponentSource `json:"source,omitempty"`
+	Source ComponentSource `json:"source,omitempty" protobuf:"bytes,4,opt,name=source"`
 }
 
-// SnapshotArtifacts is a placeholder section for 'artifact links' we want to maintain to other AppStudio resources.
-//
-// For example: here I'm imagining we might want to keep track of container image <=> (source code repo, commit sha) links,
-// Which might be useful to present to the user within the UI.
+// SnapshotArtifacts holds the 'artifact links' we want to maintain to other AppStudio resources,
+// such as container image <=> (source code repo, commit sha) links, SBOMs, attestations and test results,
+// which might be useful to present to the user within the UI.
 type SnapshotArtifacts struct {
 
-	// NOTE: This field (and struct) are placeholders.
-	// - Until this API is stabilized, consumers of the API may store any unstructured JSON/YAML data here,
+	// Images links the container images of the Snapshot to their source and supply chain artifacts.
+	// Optional.
+	// +optional
+	Images []SnapshotImageArtifact `json:"images,omitempty" protobuf:"bytes,1,rep,name=images"`
+
+	// TestResults contains the results of the tests which were run against the Snapshot.
+	// Optional.
+	// +optional
+	TestResults []SnapshotTestResult `json:"testResults,omitempty" protobuf:"bytes,2,rep,name=testResults"`
+
+	// NOTE: This field is kept for experiments with artifact links which are not part of the typed schema yet.
+	// - Consumers of the API may store any unstructured JSON/YAML data here,
 	//   but no backwards compatibility will be preserved.
-	UnstableFields *apiextensionsv1.JSON `json:"unstableFields,omitempty"`
+	// - During the transition to the typed fields, 'images' and 'testResults' keys stored here using the
+	//   layout of the typed fields are still read by the SnapshotArtifacts accessor functions.
+	UnstableFields *apiextensionsv1.JSON `json:"unstableFields,omitempty" protobuf:"bytes,3,opt,name=unstableFields"`
+}
+
+// SnapshotImageArtifact links a container image of the Snapshot to its source and supply chain artifacts
+type SnapshotImageArtifact struct {
+
+	// ContainerImage is the container image the links refer to, as listed in the Snapshot components.
+	// Example: quay.io/someorg/somerepository@sha256:5ca85b7f7b9da18a9c4101e81ee1d9bac35ac2b0b0221908ff7389204660a262.
+	// Required.
+	// +required
+	ContainerImage string `json:"containerImage" protobuf:"bytes,1,opt,name=containerImage"`
+
+	// Component is the name of the Snapshot component the image belongs to.
+	// Optional.
+	// +optional
+	Component string `json:"component,omitempty" protobuf:"bytes,2,opt,name=component"`
+
+	// SourceRepository is the URL of the git repository the image was built from.
+	// Example: https://github.com/devfile-samples/devfile-sample-python-basic.
+	// Optional.
+	// +optional
+	SourceRepository string `json:"sourceRepository,omitempty" protobuf:"bytes,3,opt,name=sourceRepository"`
+
+	// SourceCommit is the commit id (SHA-1 checksum) the image was built from.
+	// Example: 41fbdb124775323f58fd5ce93c70bb7d79c20650.
+	// Optional.
+	// +optional
+	SourceCommit string `json:"sourceCommit,omitempty" protobuf:"bytes,4,opt,name=sourceCommit"`
+
+	// SBOM is the location of the Software Bill of Materials of the image.
+	// Optional.
+	// +optional
+	SBOM *SnapshotSBOMArtifact `json:"sbom,omitempty" protobuf:"bytes,5,opt,name=sbom"`
+
+	// Attestations are references to the attestations of the image, such as SLSA provenance.
+	// Optional.
+	// +optional
+	Attestations []SnapshotAttestationRef `json:"attestations,omitempty" protobuf:"bytes,6,rep,name=attestations"`
+}
+
+// SnapshotSBOMArtifact describes where the Software Bill of Materials of an image can be found
+type SnapshotSBOMArtifact struct {
+
+	// Location is the URL or OCI reference of the SBOM.
+	// Example: quay.io/someorg/somerepository:sha256-5ca85b7f7b9da18a9c4101e81ee1d9bac35ac2b0b0221908ff7389204660a262.sbom.
+	// Required.
+	// +required
+	Location string `json:"location" protobuf:"bytes,1,opt,name=location"`
+
+	// Format is the format of the SBOM.
+	// Example: spdx or cyclonedx.
+	// Optional.
+	// +optional
+	Format string `json:"format,omitempty" protobuf:"bytes,2,opt,name=format"`
+}
+
+// SnapshotAttestationRef is a reference to an attestation of an image
+type SnapshotAttestationRef struct {
+
+	// PredicateType is the in-toto predicate type of the attestation.
+	// Example: https://slsa.dev/provenance/v1.
+	// Required.
+	// +required
+	PredicateType string `json:"predicateType" protobuf:"bytes,1,opt,name=predicateType"`
+
+	// Reference is the URL or OCI reference of the attestation.
+	// Required.
+	// +required
+	Reference string `json:"reference" protobuf:"bytes,2,opt,name=reference"`
+}
+
+// SnapshotTestOutcome is the outcome of a test run against a Snapshot
+// +kubebuilder:validation:Enum=Passed;Failed;Errored;Skipped
+type SnapshotTestOutcome string
+
+const (
+	SnapshotTestPassed  SnapshotTestOutcome = "Passed"
+	SnapshotTestFailed  SnapshotTestOutcome = "Failed"
+	SnapshotTestErrored SnapshotTestOutcome = "Errored"
+	SnapshotTestSkipped SnapshotTestOutcome = "Skipped"
+)
+
+// SnapshotTestResult is the result of a test run against a Snapshot
+type SnapshotTestResult struct {
+
+	// Scenario is the name of the test scenario that was run.
+	// Required.
+	// +required
+	Scenario string `json:"scenario" protobuf:"bytes,1,opt,name=scenario"`
+
+	// Outcome is the outcome of the test: Passed, Failed, Errored or Skipped.
+	// Required.
+	// +required
+	Outcome SnapshotTestOutcome `json:"outcome" protobuf:"bytes,2,opt,name=outcome,casttype=SnapshotTestOutcome"`
+
+	// PipelineRun is the name of the PipelineRun that ran the test.
+	// Optional.
+	// +optional
+	PipelineRun string `json:"pipelineRun,omitempty" protobuf:"bytes,3,opt,name=pipelineRun"`
+
+	// URL is a link to the detailed results of the test.
+	// Optional.
+	// +optional
+	URL string `json:"url,omitempty" protobuf:"bytes,4,opt,name=url"`
+
+	// CompletionTime is the time at which the test finished.
+	// Optional.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty" protobuf:"bytes,5,opt,name=completionTime"`
 }
 
 // SnapshotStatus defines the observed state of Snapshot
 type SnapshotStatus struct {
 	// Conditions represent the latest available observations for the Snapshot
 	// +optional
-	Conditions []metav1.Condition `json:"conditions"`
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
 
 	// ParentSnapshots contains a map of ComponentGroups that are parents of the
 	// ComponentGroup for which the snapshot was created and their corresponding
 	// snapshots
-	ParentSnapshots map[string]ParentSnapshotData `json:"parentSnapshots,omitempty"`
+	ParentSnapshots map[string]ParentSnapshotData `json:"parentSnapshots,omitempty" protobuf:"bytes,2,rep,name=parentSnapshots"`
 }
 
 type ParentSnapshotData struct {
 	// Name of the parent snapshot
 	// +optional
-	Name string `json:"name,omitempty"`
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 
 	// Whether the Snapshot has been created
-	Created bool `json:"created"`
+	Created bool `json:"created" protobuf:"varint,2,opt,name=created"`
 
 	// If the snapshot could not be created, this will contain an error string
 	// If it was created, this will contain a success message
 	// +optional
-	Message string `json:"err,omitempty"`
+	Message string `json:"err,omitempty" protobuf:"bytes,3,opt,name=err"`
 }
 
 //+kubebuilder:object:root=true
@@ -109,10 +248,10 @@ type ParentSnapshotData struct {
 // +kubebuilder:resource:path=snapshots,shortName=as;snapshot
 type Snapshot struct {
 	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Spec   SnapshotSpec   `json:"spec,omitempty"`
-	Status SnapshotStatus `json:"status,omitempty"`
+	Spec   SnapshotSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status SnapshotStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 //+kubebuilder:object:root=true
@@ -120,8 +259,8 @@ type Snapshot struct {
 // SnapshotList contains a list of Snapshot
 type SnapshotList struct {
 	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []Snapshot `json:"items"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []Snapshot `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
 func init() {
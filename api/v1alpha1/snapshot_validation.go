@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateSnapshotUpdate checks that an update of a Snapshot leaves its application and components unchanged.
+// It mirrors the CEL transition rules of the Snapshot CRD, so that consumers which do not go through the
+// API server (such as the fake client) reject the same updates. The display fields may be changed freely.
+// As spec.components is a map list, the components may be reordered.
+func ValidateSnapshotUpdate(newSnapshot, oldSnapshot *Snapshot) error {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if newSnapshot.Spec.Application != oldSnapshot.Spec.Application {
+		errs = append(errs, field.Forbidden(specPath.Child("application"), SnapshotApplicationUpdateError))
+	}
+	if !equalSnapshotComponents(newSnapshot.Spec.Components, oldSnapshot.Spec.Components) {
+		errs = append(errs, field.Forbidden(specPath.Child("components"), SnapshotComponentsUpdateError))
+	}
+
+	return errs.ToAggregate()
+}
+
+// equalSnapshotComponents returns whether two lists hold the same components, in any order. The components are
+// matched by name and version, the keys of the spec.components map list.
+func equalSnapshotComponents(a, b []SnapshotComponent) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	type key struct{ name, version string }
+	byKey := make(map[key]*SnapshotComponent, len(a))
+	for i := range a {
+		byKey[key{a[i].Name, a[i].Version}] = &a[i]
+	}
+	if len(byKey) != len(a) {
+		// Duplicate keys, which the API server rejects: only the same list is unchanged
+		return equality.Semantic.DeepEqual(a, b)
+	}
+	for i := range b {
+		k := key{b[i].Name, b[i].Version}
+		component, found := byKey[k]
+		if !found || !equality.Semantic.DeepEqual(*component, b[i]) {
+			return false
+		}
+		delete(byKey, k)
+	}
+	return true
+}
@@ -24,7 +24,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -242,6 +242,13 @@ func (in *ComponentCreatePipelineConfiguration) DeepCopy() *ComponentCreatePipel
 func (in *ComponentDetectionDescription) DeepCopyInto(out *ComponentDetectionDescription) {
 	*out = *in
 	in.ComponentStub.DeepCopyInto(&out.ComponentStub)
+	if in.ProposedVersions != nil {
+		in, out := &in.ProposedVersions, &out.ProposedVersions
+		*out = make([]ComponentVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDetectionDescription.
@@ -280,7 +287,7 @@ func (in *ComponentDetectionQuery) DeepCopyInto(out *ComponentDetectionQuery) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -338,6 +345,11 @@ func (in *ComponentDetectionQueryList) DeepCopyObject() runtime.Object {
 func (in *ComponentDetectionQuerySpec) DeepCopyInto(out *ComponentDetectionQuerySpec) {
 	*out = *in
 	out.GitSource = in.GitSource
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDetectionQuerySpec.
@@ -442,6 +454,11 @@ func (in *ComponentSourceUnion) DeepCopyInto(out *ComponentSourceUnion) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ImageSource != nil {
+		in, out := &in.ImageSource, &out.ImageSource
+		*out = new(ImageSource)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSourceUnion.
@@ -515,7 +532,9 @@ func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
 	if in.Versions != nil {
 		in, out := &in.Versions, &out.Versions
 		*out = make([]ComponentVersionStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -537,6 +556,16 @@ func (in *ComponentVersion) DeepCopyInto(out *ComponentVersion) {
 		*out = new(ComponentBuildPipeline)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IncludePaths != nil {
+		in, out := &in.IncludePaths, &out.IncludePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludePaths != nil {
+		in, out := &in.ExcludePaths, &out.ExcludePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVersion.
@@ -552,6 +581,14 @@ func (in *ComponentVersion) DeepCopy() *ComponentVersion {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentVersionStatus) DeepCopyInto(out *ComponentVersionStatus) {
 	*out = *in
+	if in.OnboardingTimestamp != nil {
+		in, out := &in.OnboardingTimestamp, &out.OnboardingTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.LastBuildTimestamp != nil {
+		in, out := &in.LastBuildTimestamp, &out.LastBuildTimestamp
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVersionStatus.
@@ -594,6 +631,21 @@ func (in *GitSource) DeepCopy() *GitSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSource) DeepCopyInto(out *ImageSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSource.
+func (in *ImageSource) DeepCopy() *ImageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParentSnapshotData) DeepCopyInto(out *ParentSnapshotData) {
 	*out = *in
@@ -714,6 +766,20 @@ func (in *Snapshot) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SnapshotArtifacts) DeepCopyInto(out *SnapshotArtifacts) {
 	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]SnapshotImageArtifact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TestResults != nil {
+		in, out := &in.TestResults, &out.TestResults
+		*out = make([]SnapshotTestResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.UnstableFields != nil {
 		in, out := &in.UnstableFields, &out.UnstableFields
 		*out = new(apiextensionsv1.JSON)
@@ -731,6 +797,21 @@ func (in *SnapshotArtifacts) DeepCopy() *SnapshotArtifacts {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotAttestationRef) DeepCopyInto(out *SnapshotAttestationRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotAttestationRef.
+func (in *SnapshotAttestationRef) DeepCopy() *SnapshotAttestationRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotAttestationRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SnapshotComponent) DeepCopyInto(out *SnapshotComponent) {
 	*out = *in
@@ -747,6 +828,31 @@ func (in *SnapshotComponent) DeepCopy() *SnapshotComponent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotImageArtifact) DeepCopyInto(out *SnapshotImageArtifact) {
+	*out = *in
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(SnapshotSBOMArtifact)
+		**out = **in
+	}
+	if in.Attestations != nil {
+		in, out := &in.Attestations, &out.Attestations
+		*out = make([]SnapshotAttestationRef, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotImageArtifact.
+func (in *SnapshotImageArtifact) DeepCopy() *SnapshotImageArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotImageArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SnapshotList) DeepCopyInto(out *SnapshotList) {
 	*out = *in
@@ -779,6 +885,21 @@ func (in *SnapshotList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSBOMArtifact) DeepCopyInto(out *SnapshotSBOMArtifact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSBOMArtifact.
+func (in *SnapshotSBOMArtifact) DeepCopy() *SnapshotSBOMArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSBOMArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SnapshotSpec) DeepCopyInto(out *SnapshotSpec) {
 	*out = *in
@@ -830,3 +951,22 @@ func (in *SnapshotStatus) DeepCopy() *SnapshotStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotTestResult) DeepCopyInto(out *SnapshotTestResult) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotTestResult.
+func (in *SnapshotTestResult) DeepCopy() *SnapshotTestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotTestResult)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -65,6 +65,7 @@ import (
 )
 
 // Builder builds a new Scheme for mapping go types to Kubernetes GroupVersionKinds.
+// +protobuf=false
 type Builder struct {
 	GroupVersion schema.GroupVersion
 	runtime.SchemeBuilder
@@ -0,0 +1,49 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package crd embeds the CustomResourceDefinitions of the API generated in config/crd/bases, so that consumers
+// can install them in a cluster or an envtest environment without copying their YAML:
+//
+//	if err := crd.Install(ctx, clientset.ApiextensionsV1().CustomResourceDefinitions()); err != nil {
+//		...
+//	}
+package crd
+
+import (
+	"embed"
+	"io/fs"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+//go:embed bases/*.yaml
+var files embed.FS
+
+// Bases is the directory of the CustomResourceDefinitions, as generated in config/crd/bases
+var Bases fs.FS
+
+func init() {
+	var err error
+	if Bases, err = fs.Sub(files, "bases"); err != nil {
+		panic(err)
+	}
+}
+
+// CustomResourceDefinitions returns the CustomResourceDefinitions of the API, sorted by file name. Every call
+// returns new objects, which the caller may modify.
+func CustomResourceDefinitions() ([]apiextensionsv1.CustomResourceDefinition, error) {
+	return Load(Bases)
+}
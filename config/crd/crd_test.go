@@ -0,0 +1,81 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/konflux-ci/application-api/config/crd"
+)
+
+func TestCustomResourceDefinitions(t *testing.T) {
+	files, err := filepath.Glob("bases/*.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	crds, err := crd.CustomResourceDefinitions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(crds) != len(files) {
+		t.Fatalf("got %d CustomResourceDefinitions, want one per file of bases: %q", len(crds), files)
+	}
+	for i, file := range files {
+		// Files are named group_plural.yaml, and CustomResourceDefinitions plural.group
+		group, plural, _ := strings.Cut(strings.TrimSuffix(filepath.Base(file), ".yaml"), "_")
+		if want := plural + "." + group; crds[i].Name != want {
+			t.Errorf("CustomResourceDefinition %d is %s, want %s from %s", i, crds[i].Name, want, file)
+		}
+	}
+
+	// Every call returns new objects
+	crds[0].Name = "changed"
+	again, err := crd.CustomResourceDefinitions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again[0].Name == "changed" {
+		t.Error("CustomResourceDefinitions() returned objects shared between calls")
+	}
+}
+
+func TestLoadIgnoresOtherDocuments(t *testing.T) {
+	dir := t.TempDir()
+	crdYAML, err := os.ReadFile("bases/appstudio.redhat.com_applications.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"crds.yml":           "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: other\n---\n" + string(crdYAML),
+		"kustomization.yaml": "resources:\n- crds.yml\n",
+		"README.md":          "not YAML",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	crds, err := crd.Load(os.DirFS(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(crds) != 1 || crds[0].Name != "applications.appstudio.redhat.com" {
+		t.Errorf("Load() = %d CustomResourceDefinitions, want only applications.appstudio.redhat.com", len(crds))
+	}
+}
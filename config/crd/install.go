@@ -0,0 +1,127 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// PollInterval is the interval between two checks of whether the installed CustomResourceDefinitions are established
+const PollInterval = 250 * time.Millisecond
+
+// Client is the subset of the CustomResourceDefinition client of the apiextensions clientset used by Install, so
+// that clientset.ApiextensionsV1().CustomResourceDefinitions() can be passed without this module depending on
+// client-go.
+type Client interface {
+	Get(ctx context.Context, name string, options metav1.GetOptions) (*apiextensionsv1.CustomResourceDefinition, error)
+	Create(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition, options metav1.CreateOptions) (*apiextensionsv1.CustomResourceDefinition, error)
+	Update(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition, options metav1.UpdateOptions) (*apiextensionsv1.CustomResourceDefinition, error)
+}
+
+// Install creates the CustomResourceDefinitions of the API, or upgrades those which are already installed to the
+// embedded ones, then waits until all of them are established and their resources can be served. Use a context
+// with a deadline to bound the wait.
+func Install(ctx context.Context, client Client) error {
+	crds, err := CustomResourceDefinitions()
+	if err != nil {
+		return err
+	}
+	for i := range crds {
+		if err := apply(ctx, client, &crds[i]); err != nil {
+			return err
+		}
+	}
+	for _, crd := range crds {
+		if err := waitForEstablished(ctx, client, crd.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply creates a CustomResourceDefinition, or updates the installed one to it. The labels and annotations set on
+// the installed one by others are kept.
+func apply(ctx context.Context, client Client, crd *apiextensionsv1.CustomResourceDefinition) error {
+	installed, err := client.Get(ctx, crd.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if _, err := client.Create(ctx, crd, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("unable to install CustomResourceDefinition %s: %w", crd.Name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get CustomResourceDefinition %s: %w", crd.Name, err)
+	}
+
+	upgraded := installed.DeepCopy()
+	upgraded.Spec = crd.Spec
+	upgraded.Labels = merge(upgraded.Labels, crd.Labels)
+	upgraded.Annotations = merge(upgraded.Annotations, crd.Annotations)
+	if _, err := client.Update(ctx, upgraded, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("unable to upgrade CustomResourceDefinition %s: %w", crd.Name, err)
+	}
+	return nil
+}
+
+// waitForEstablished waits until a CustomResourceDefinition is established, and fails early if its names are
+// rejected because they conflict with another one.
+func waitForEstablished(ctx context.Context, client Client, name string) error {
+	var last *apiextensionsv1.CustomResourceDefinition
+	err := wait.PollImmediateUntilWithContext(ctx, PollInterval, func(ctx context.Context) (bool, error) {
+		crd, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		last = crd
+		for _, condition := range crd.Status.Conditions {
+			if condition.Type == apiextensionsv1.NamesAccepted && condition.Status == apiextensionsv1.ConditionFalse {
+				return false, fmt.Errorf("names of CustomResourceDefinition %s are not accepted: %s", name, condition.Message)
+			}
+		}
+		for _, condition := range crd.Status.Conditions {
+			if condition.Type == apiextensionsv1.Established && condition.Status == apiextensionsv1.ConditionTrue {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout && last != nil {
+		return fmt.Errorf("CustomResourceDefinition %s is not established: %v", name, last.Status.Conditions)
+	}
+	return err
+}
+
+// merge returns the values of a map with the values of another one set.
+func merge(values, set map[string]string) map[string]string {
+	if len(set) == 0 {
+		return values
+	}
+	if values == nil {
+		values = make(map[string]string, len(set))
+	}
+	for key, value := range set {
+		values[key] = value
+	}
+	return values
+}
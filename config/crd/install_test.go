@@ -0,0 +1,180 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/konflux-ci/application-api/config/crd"
+)
+
+// fakeClient stores CustomResourceDefinitions in memory, and sets their conditions when they are read
+type fakeClient struct {
+	crds    map[string]*apiextensionsv1.CustomResourceDefinition
+	created []string
+	updated []string
+
+	// conditions are the conditions of the CustomResourceDefinitions returned by Get
+	conditions []apiextensionsv1.CustomResourceDefinitionCondition
+}
+
+func newFakeClient(conditions ...apiextensionsv1.CustomResourceDefinitionCondition) *fakeClient {
+	return &fakeClient{crds: map[string]*apiextensionsv1.CustomResourceDefinition{}, conditions: conditions}
+}
+
+func (c *fakeClient) Get(_ context.Context, name string, _ metav1.GetOptions) (*apiextensionsv1.CustomResourceDefinition, error) {
+	stored, ok := c.crds[name]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}, name)
+	}
+	found := stored.DeepCopy()
+	found.Status.Conditions = c.conditions
+	return found, nil
+}
+
+func (c *fakeClient) Create(_ context.Context, crd *apiextensionsv1.CustomResourceDefinition, _ metav1.CreateOptions) (*apiextensionsv1.CustomResourceDefinition, error) {
+	if _, ok := c.crds[crd.Name]; ok {
+		return nil, apierrors.NewAlreadyExists(schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}, crd.Name)
+	}
+	c.crds[crd.Name] = crd.DeepCopy()
+	c.created = append(c.created, crd.Name)
+	return crd, nil
+}
+
+func (c *fakeClient) Update(_ context.Context, crd *apiextensionsv1.CustomResourceDefinition, _ metav1.UpdateOptions) (*apiextensionsv1.CustomResourceDefinition, error) {
+	c.crds[crd.Name] = crd.DeepCopy()
+	c.updated = append(c.updated, crd.Name)
+	return crd, nil
+}
+
+var established = apiextensionsv1.CustomResourceDefinitionCondition{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue}
+
+// crdNames returns the names of the CustomResourceDefinitions of the API.
+func crdNames(t *testing.T) []string {
+	crds, err := crd.CustomResourceDefinitions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(crds))
+	for _, crd := range crds {
+		names = append(names, crd.Name)
+	}
+	return names
+}
+
+func TestInstallCreate(t *testing.T) {
+	client := newFakeClient(established)
+	if err := crd.Install(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	if want := crdNames(t); !reflect.DeepEqual(client.created, want) || len(client.updated) != 0 {
+		t.Errorf("created %q and updated %q, want to create %q", client.created, client.updated, want)
+	}
+}
+
+func TestInstallUpgrade(t *testing.T) {
+	crds, err := crd.CustomResourceDefinitions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newFakeClient(established)
+	for _, installed := range crds {
+		installed.Labels = map[string]string{"owner": "someone-else"}
+		installed.Annotations = map[string]string{"note": "kept", "controller-gen.kubebuilder.io/version": "old"}
+		installed.Spec.Versions = nil
+		client.crds[installed.Name] = installed.DeepCopy()
+	}
+
+	if err := crd.Install(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	if want := crdNames(t); !reflect.DeepEqual(client.updated, want) || len(client.created) != 0 {
+		t.Errorf("created %q and updated %q, want to update %q", client.created, client.updated, want)
+	}
+	for _, embedded := range crds {
+		upgraded := client.crds[embedded.Name]
+		if !reflect.DeepEqual(upgraded.Spec, embedded.Spec) {
+			t.Errorf("CustomResourceDefinition %s: spec was not upgraded", embedded.Name)
+		}
+		if upgraded.Labels["owner"] != "someone-else" {
+			t.Errorf("CustomResourceDefinition %s: labels %v, want the owner label kept", embedded.Name, upgraded.Labels)
+		}
+		if upgraded.Annotations["note"] != "kept" ||
+			upgraded.Annotations["controller-gen.kubebuilder.io/version"] != embedded.Annotations["controller-gen.kubebuilder.io/version"] {
+			t.Errorf("CustomResourceDefinition %s: annotations %v, want the note kept and the version upgraded", embedded.Name, upgraded.Annotations)
+		}
+	}
+}
+
+func TestInstallNamesNotAccepted(t *testing.T) {
+	client := newFakeClient(apiextensionsv1.CustomResourceDefinitionCondition{
+		Type:    apiextensionsv1.NamesAccepted,
+		Status:  apiextensionsv1.ConditionFalse,
+		Message: "plural is already in use",
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	start := time.Now()
+	err := crd.Install(ctx, client)
+	if err == nil || !strings.Contains(err.Error(), "are not accepted: plural is already in use") {
+		t.Fatalf("Install() = %v, want an error about the names", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*crd.PollInterval {
+		t.Errorf("Install() failed after %v, want it to fail early", elapsed)
+	}
+}
+
+func TestInstallTimeout(t *testing.T) {
+	client := newFakeClient(apiextensionsv1.CustomResourceDefinitionCondition{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionFalse})
+	ctx, cancel := context.WithTimeout(context.Background(), 2*crd.PollInterval)
+	defer cancel()
+
+	err := crd.Install(ctx, client)
+	if err == nil || !strings.Contains(err.Error(), "is not established") {
+		t.Fatalf("Install() = %v, want an error about the CustomResourceDefinition not being established", err)
+	}
+}
+
+func TestInstallCreateError(t *testing.T) {
+	client := &failingClient{fakeClient: newFakeClient(established)}
+	err := crd.Install(context.Background(), client)
+	if !errors.Is(err, errCreate) {
+		t.Fatalf("Install() = %v, want %v", err, errCreate)
+	}
+}
+
+var errCreate = errors.New("create failed")
+
+// failingClient fails to create CustomResourceDefinitions
+type failingClient struct {
+	*fakeClient
+}
+
+func (c *failingClient) Create(context.Context, *apiextensionsv1.CustomResourceDefinition, metav1.CreateOptions) (*apiextensionsv1.CustomResourceDefinition, error) {
+	return nil, errCreate
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	sigsjson "sigs.k8s.io/json"
+	"sigs.k8s.io/yaml"
+)
+
+// Load reads the CustomResourceDefinitions of the YAML files at the root of fsys, sorted by file name.
+// Documents of other kinds are ignored.
+func Load(fsys fs.FS) ([]apiextensionsv1.CustomResourceDefinition, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	var crds []apiextensionsv1.CustomResourceDefinition
+	for _, entry := range entries {
+		if entry.IsDir() || !isYAMLFile(entry.Name()) {
+			continue
+		}
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		fileCRDs, err := decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s: %w", entry.Name(), err)
+		}
+		crds = append(crds, fileCRDs...)
+	}
+	return crds, nil
+}
+
+// decode returns the CustomResourceDefinitions of the documents of a YAML file.
+func decode(data []byte) ([]apiextensionsv1.CustomResourceDefinition, error) {
+	var crds []apiextensionsv1.CustomResourceDefinition
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		document, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return crds, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		jsonData, err := yaml.YAMLToJSON(document)
+		if err != nil {
+			return nil, err
+		}
+		var typeMeta metav1.TypeMeta
+		if err := yaml.Unmarshal(jsonData, &typeMeta); err != nil {
+			return nil, err
+		}
+		if typeMeta.Kind != "CustomResourceDefinition" {
+			continue
+		}
+		crd := apiextensionsv1.CustomResourceDefinition{}
+		if err := sigsjson.UnmarshalCaseSensitivePreserveInts(jsonData, &crd); err != nil {
+			return nil, err
+		}
+		crds = append(crds, crd)
+	}
+}
+
+func isYAMLFile(name string) bool {
+	extension := path.Ext(name)
+	return extension == ".yaml" || extension == ".yml"
+}
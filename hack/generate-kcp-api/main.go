@@ -0,0 +1,76 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command generate-kcp-api generates the KCP APIResourceSchemas, APIExport and APIBinding of the API from its
+// CustomResourceDefinitions, see package kcp:
+//
+//	go run ./hack/generate-kcp-api [-crds config/crd/bases] [-output config/kcp]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/konflux-ci/application-api/config/crd"
+	"github.com/konflux-ci/application-api/pkg/kcp"
+)
+
+func main() {
+	crdDir := flag.String("crds", "config/crd/bases", "directory of the CustomResourceDefinitions")
+	outputDir := flag.String("output", "config/kcp", "directory of the generated KCP manifests")
+	flag.Parse()
+
+	if err := run(*crdDir, *outputDir); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(crdDir, outputDir string) error {
+	crds, err := crd.Load(os.DirFS(crdDir))
+	if err != nil {
+		return err
+	}
+	if len(crds) == 0 {
+		return fmt.Errorf("no CustomResourceDefinition found in %s", crdDir)
+	}
+	files, err := kcp.Generate(crds)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := filepath.Join(outputDir, name)
+		if current, err := os.ReadFile(path); err == nil && bytes.Equal(current, files[name]) {
+			fmt.Printf("%s is up to date\n", path)
+			continue
+		}
+		if err := os.WriteFile(path, files[name], 0644); err != nil {
+			return err
+		}
+		fmt.Printf("updated %s\n", path)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+#!/bin/sh
+
+# Generates the protobuf serialization of the API types, api/v1alpha1/generated.proto and generated.pb.go, and the
+# protobuf tags of their fields with go-to-protobuf. Field numbers are kept from the existing protobuf tags, so
+# new fields are appended to the messages.
+
+set -e
+
+if ! command -v protoc > /dev/null; then
+  echo "protoc required on path"
+  echo "you can download it from https://github.com/protocolbuffers/protobuf/releases"
+  exit 1
+fi
+
+THIS_DIR="$(dirname "$(realpath "$0")")"
+ROOT_DIR="$(realpath "${THIS_DIR}/..")"
+BIN_DIR="${BIN_DIR:-${ROOT_DIR}/bin}"
+MODULE="github.com/konflux-ci/application-api"
+PACKAGE="api/v1alpha1"
+
+# go-to-protobuf only understands GOPATH trees, so generate in a temporary one holding the module and its vendored
+# dependencies, which also provide the .proto files of the Kubernetes types
+GOPATH_DIR="$(mktemp -d)"
+trap 'rm -rf "${GOPATH_DIR}"' EXIT
+SRC_DIR="${GOPATH_DIR}/src"
+MODULE_DIR="${SRC_DIR}/${MODULE}"
+mkdir -p "${MODULE_DIR}"
+tar -C "${ROOT_DIR}" --exclude ./.git --exclude ./bin --exclude ./vendor -cf - . | tar -C "${MODULE_DIR}" -xf -
+(cd "${MODULE_DIR}" && go mod vendor)
+ln -s "${MODULE_DIR}/vendor/k8s.io" "${SRC_DIR}/k8s.io"
+
+GOGO_DIR="$(cd "${ROOT_DIR}" && go list -m -f '{{.Dir}}' github.com/gogo/protobuf)"
+mkdir -p "${GOPATH_DIR}/proto/github.com/gogo"
+ln -s "${GOGO_DIR}" "${GOPATH_DIR}/proto/github.com/gogo/protobuf"
+
+cd "${SRC_DIR}"
+PATH="${BIN_DIR}:${PATH}" GO111MODULE=off GOPATH="${GOPATH_DIR}" GOFLAGS="" "${BIN_DIR}/go-to-protobuf" \
+  --packages "${MODULE}/${PACKAGE}" \
+  --apimachinery-packages "-k8s.io/apimachinery/pkg/util/intstr,-k8s.io/apimachinery/pkg/api/resource,-k8s.io/apimachinery/pkg/runtime/schema,-k8s.io/apimachinery/pkg/runtime,-k8s.io/apimachinery/pkg/apis/meta/v1,-k8s.io/api/core/v1,-k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1" \
+  --output-base "${SRC_DIR}" \
+  --vendor-output-base "${MODULE_DIR}/vendor" \
+  --proto-import "${GOPATH_DIR}/proto" \
+  --proto-import "${GOGO_DIR}/protobuf" \
+  --go-header-file "${ROOT_DIR}/hack/boilerplate.go.txt"
+
+cp "${MODULE_DIR}/${PACKAGE}"/*.go "${MODULE_DIR}/${PACKAGE}/generated.proto" "${ROOT_DIR}/${PACKAGE}/"
+echo "updated protobuf serialization in '${ROOT_DIR}/${PACKAGE}'"
@@ -13,6 +13,110 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// Command application-api validates YAML manifests of the API offline, so that GitOps repositories can lint
+// their objects before pushing them:
+//
+//	application-api [flags] [path ...]
+//
+// Each path is a manifest file or a directory searched recursively for .yaml and .yml files; the current
+// directory is used when no path is given. Errors are printed as file:line messages, and the exit status is
+// 1 if any manifest is invalid or 2 if the manifests cannot be read.
 package main
 
-// Dummy main.go file to keep kubebuilder happy
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/application-api/config/crd"
+	"github.com/konflux-ci/application-api/pkg/gitutil"
+	"github.com/konflux-ci/application-api/pkg/lint"
+)
+
+func main() {
+	crdDir := flag.String("crds", "", "directory of the CustomResourceDefinitions to validate against, instead of the built-in ones")
+	vendors := flag.String("allowed-vendors", "", "comma separated list of the allowed git vendors: "+
+		"github, gitlab, bitbucket, forgejo, self-hosted; any vendor is allowed when empty")
+	gitHosts := flag.String("git-hosts", "", "comma separated list of the self-managed git servers and their vendor, "+
+		"as host=vendor such as gitlab.example.com=gitlab; other hosts than the public services are self-hosted")
+	secrets := flag.String("allowed-secrets", "*", "comma separated list of the Secrets that environment variables "+
+		"of Components may reference, as names or patterns such as app-*")
+	configMaps := flag.String("allowed-configmaps", "*", "comma separated list of the ConfigMaps that environment "+
+		"variables of Components may reference, as names or patterns such as app-*")
+	skipReferences := flag.Bool("skip-references", false, "do not check references between objects, "+
+		"for manifests referencing objects defined elsewhere")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [path ...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	env := &v1alpha1.ComponentEnvOptions{AllowedSecrets: splitList(*secrets), AllowedConfigMaps: splitList(*configMaps)}
+	os.Exit(run(*crdDir, *vendors, *gitHosts, env, *skipReferences, flag.Args()))
+}
+
+func run(crdDir, vendors, gitHosts string, env *v1alpha1.ComponentEnvOptions, skipReferences bool, paths []string) int {
+	// The built-in CustomResourceDefinitions are used unless -crds is set
+	crdFS := crd.Bases
+	if crdDir != "" {
+		crdFS = os.DirFS(crdDir)
+	}
+	crds, err := crd.Load(crdFS)
+	if err != nil {
+		return fail(err)
+	}
+
+	options := lint.Options{Env: env, SkipReferences: skipReferences}
+	for _, vendor := range splitList(vendors) {
+		options.AllowedVendors = append(options.AllowedVendors, gitutil.Vendor(vendor))
+	}
+	for _, entry := range splitList(gitHosts) {
+		host, vendor, found := strings.Cut(entry, "=")
+		if !found || strings.TrimSpace(host) == "" || strings.TrimSpace(vendor) == "" {
+			return fail(fmt.Errorf("invalid git host %q, expected host=vendor", entry))
+		}
+		if options.GitHosts == nil {
+			options.GitHosts = map[string]gitutil.Vendor{}
+		}
+		options.GitHosts[strings.TrimSpace(host)] = gitutil.Vendor(strings.TrimSpace(vendor))
+	}
+	validator, err := lint.NewValidator(crds, options)
+	if err != nil {
+		return fail(err)
+	}
+
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	files, err := lint.ReadFiles(paths...)
+	if err != nil {
+		return fail(err)
+	}
+
+	errs := validator.Validate(files)
+	for _, err := range errs {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	if len(errs) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// splitList splits a comma separated list, leaving out empty entries.
+func splitList(list string) []string {
+	var entries []string
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func fail(err error) int {
+	fmt.Fprintln(os.Stderr, err)
+	return 2
+}
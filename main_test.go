@@ -0,0 +1,54 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+)
+
+func TestRun(t *testing.T) {
+	env := &v1alpha1.ComponentEnvOptions{AllowedSecrets: []string{"*"}, AllowedConfigMaps: []string{"*"}}
+	tests := []struct {
+		name           string
+		crdDir         string
+		vendors        string
+		gitHosts       string
+		skipReferences bool
+		paths          []string
+		want           int
+	}{
+		{name: "valid", paths: []string{"pkg/lint/testdata/valid.yaml"}, want: 0},
+		{name: "invalid", paths: []string{"pkg/lint/testdata/manifests.yaml"}, want: 1},
+		{name: "missing references skipped", skipReferences: true, paths: []string{"pkg/lint/testdata/references.yaml"}, want: 0},
+		{name: "missing references", paths: []string{"pkg/lint/testdata/references.yaml"}, want: 1},
+		{name: "disallowed vendor", vendors: "gitlab", paths: []string{"pkg/lint/testdata/valid.yaml"}, want: 1},
+		{name: "self-managed git host", vendors: "gitlab", gitHosts: "github.com=gitlab", paths: []string{"pkg/lint/testdata/valid.yaml"}, want: 0},
+		{name: "CustomResourceDefinitions directory", crdDir: "config/crd/bases", paths: []string{"pkg/lint/testdata/valid.yaml"}, want: 0},
+		{name: "invalid git host", gitHosts: "gitlab.example.com", paths: []string{"pkg/lint/testdata/valid.yaml"}, want: 2},
+		{name: "missing file", paths: []string{"pkg/lint/testdata/missing.yaml"}, want: 2},
+		{name: "missing CustomResourceDefinitions directory", crdDir: "missing", paths: []string{"pkg/lint/testdata/valid.yaml"}, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(tt.crdDir, tt.vendors, tt.gitHosts, env, tt.skipReferences, tt.paths); got != tt.want {
+				t.Errorf("run() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
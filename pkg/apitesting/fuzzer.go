@@ -0,0 +1,46 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apitesting
+
+import (
+	"encoding/json"
+
+	fuzz "github.com/google/gofuzz"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+)
+
+// Funcs are the fuzz functions for the types used by the API beyond those of the meta fuzzer
+func Funcs(codecs runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		// Raw JSON values, such as the unstable fields of Snapshot artifacts, must be valid JSON
+		func(j *apiextensionsv1.JSON, c fuzz.Continue) {
+			value := map[string]interface{}{}
+			for i := c.Intn(3); i > 0; i-- {
+				switch c.Intn(3) {
+				case 0:
+					value[c.RandString()] = c.RandString()
+				case 1:
+					value[c.RandString()] = c.RandBool()
+				default:
+					value[c.RandString()] = []interface{}{c.RandString(), int64(c.Int31())}
+				}
+			}
+			j.Raw, _ = json.Marshal(value)
+		},
+	}
+}
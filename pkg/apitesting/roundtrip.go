@@ -0,0 +1,260 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apitesting checks that API types survive serialization and copies: objects of every kind registered
+// in a scheme are filled with random values, then round-tripped through JSON, YAML and protobuf when the kind
+// has a protobuf serialization, and deep copied. The results must be semantically equal to the original objects,
+// and copies must not share memory with the originals.
+//
+// Projects extending the API types can run the same checks against their own scheme:
+//
+//	func TestRoundTrip(t *testing.T) {
+//		apitesting.RoundTripTypes(t, myapi.AddToScheme, apitesting.Options{})
+//	}
+package apitesting
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	fuzz "github.com/google/gofuzz"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/protobuf"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/yaml"
+)
+
+// SeedEnv is the environment variable overriding the random seed of the checks, to reproduce a failure
+const SeedEnv = "APITESTING_SEED"
+
+// DefaultIterations is the number of random objects checked per kind unless Options.Iterations is set
+const DefaultIterations = 50
+
+// Options configures the round-trip checks
+type Options struct {
+	// Iterations is the number of random objects checked per kind, DefaultIterations when zero
+	Iterations int
+
+	// Seed is the random seed of the checks. A random seed is used when zero, unless SeedEnv is set.
+	// The seed is logged so that failures can be reproduced.
+	Seed int64
+
+	// FuzzerFuncs are custom fuzz functions for types that random values would not round-trip, such as
+	// strings with a restricted format. They take precedence over the functions of the package.
+	FuzzerFuncs fuzzer.FuzzerFuncs
+
+	// SkipKinds are the kinds which are not checked
+	SkipKinds []string
+}
+
+// RoundTripTypes checks every kind that addToScheme registers, see RoundTripKind. The kinds that every group
+// version registers for the API machinery, such as WatchEvent or ListOptions, are not checked.
+func RoundTripTypes(t *testing.T, addToScheme func(*runtime.Scheme) error, options Options) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := addToScheme(scheme); err != nil {
+		t.Fatalf("unable to build the scheme: %v", err)
+	}
+	seed := options.seed(t)
+	codecs := runtimeserializer.NewCodecFactory(scheme)
+	skip := map[string]bool{}
+	for _, kind := range options.SkipKinds {
+		skip[kind] = true
+	}
+
+	for _, gvk := range apiKinds(scheme) {
+		if skip[gvk.Kind] {
+			continue
+		}
+		gvk := gvk
+		t.Run(gvk.Kind, func(t *testing.T) {
+			f := NewFuzzer(seed, codecs, options.FuzzerFuncs)
+			RoundTripKind(t, scheme, gvk, f, options.Iterations)
+		})
+	}
+}
+
+// RoundTripKind fills objects of a kind with random values, and checks that each of them is unchanged after
+// a JSON round trip, a YAML round trip, a protobuf round trip and a deep copy, and that its copy does not share
+// memory with it. The protobuf round trip is skipped for kinds without a protobuf serialization.
+// DefaultIterations objects are checked when iterations is zero.
+func RoundTripKind(t *testing.T, scheme *runtime.Scheme, gvk schema.GroupVersionKind, f *fuzz.Fuzzer, iterations int) {
+	t.Helper()
+	if iterations <= 0 {
+		iterations = DefaultIterations
+	}
+	protobufSerializer := protobuf.NewSerializer(scheme, scheme)
+	for i := 0; i < iterations; i++ {
+		object, err := scheme.New(gvk)
+		if err != nil {
+			t.Fatalf("unable to create %s: %v", gvk, err)
+		}
+		f.Fuzz(object)
+
+		checkJSON(t, scheme, gvk, object)
+		checkYAML(t, scheme, gvk, object)
+		if !checkProtobuf(t, protobufSerializer, gvk, object) {
+			t.Logf("%s has no protobuf serialization, skipping the protobuf round trip", gvk.Kind)
+			protobufSerializer = nil
+		}
+		checkDeepCopy(t, gvk, object)
+		if t.Failed() {
+			return
+		}
+	}
+}
+
+// NewFuzzer returns a fuzzer filling objects with random values that are valid for the API machinery, such as
+// object metadata, quantities, times and raw JSON. The funcs take precedence over the functions of the package.
+func NewFuzzer(seed int64, codecs runtimeserializer.CodecFactory, funcs ...fuzzer.FuzzerFuncs) *fuzz.Fuzzer {
+	all := append([]fuzzer.FuzzerFuncs{metafuzzer.Funcs, Funcs}, funcs...)
+	return fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(all...), rand.NewSource(seed), codecs)
+}
+
+func checkJSON(t *testing.T, scheme *runtime.Scheme, gvk schema.GroupVersionKind, object runtime.Object) {
+	t.Helper()
+	data, err := json.Marshal(object)
+	if err != nil {
+		t.Errorf("unable to encode %s to JSON: %v\n%#v", gvk.Kind, err, object)
+		return
+	}
+	decoded, _ := scheme.New(gvk)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Errorf("unable to decode %s from JSON: %v\n%s", gvk.Kind, err, data)
+		return
+	}
+	if !equality.Semantic.DeepEqual(object, decoded) {
+		t.Errorf("%s changed after a JSON round trip:\n%s\n%s", gvk.Kind, diff.ObjectReflectDiff(object, decoded), data)
+	}
+}
+
+func checkYAML(t *testing.T, scheme *runtime.Scheme, gvk schema.GroupVersionKind, object runtime.Object) {
+	t.Helper()
+	data, err := yaml.Marshal(object)
+	if err != nil {
+		t.Errorf("unable to encode %s to YAML: %v\n%#v", gvk.Kind, err, object)
+		return
+	}
+	decoded, _ := scheme.New(gvk)
+	if err := yaml.Unmarshal(data, decoded); err != nil {
+		t.Errorf("unable to decode %s from YAML: %v\n%s", gvk.Kind, err, data)
+		return
+	}
+	if !equality.Semantic.DeepEqual(object, decoded) {
+		t.Errorf("%s changed after a YAML round trip:\n%s\n%s", gvk.Kind, diff.ObjectReflectDiff(object, decoded), data)
+	}
+}
+
+// checkProtobuf checks the protobuf round trip of an object with the serializer used for the
+// application/vnd.kubernetes.protobuf media type. It returns false if the kind has no protobuf serialization.
+func checkProtobuf(t *testing.T, serializer *protobuf.Serializer, gvk schema.GroupVersionKind, object runtime.Object) bool {
+	t.Helper()
+	if serializer == nil {
+		return true
+	}
+	// The serializer records the kind of the object in its envelope
+	typed := object.DeepCopyObject()
+	typed.GetObjectKind().SetGroupVersionKind(gvk)
+	var data bytes.Buffer
+	if err := serializer.Encode(typed, &data); err != nil {
+		if protobuf.IsNotMarshalable(err) {
+			return false
+		}
+		t.Errorf("unable to encode %s to protobuf: %v\n%#v", gvk.Kind, err, object)
+		return true
+	}
+	decoded, _, err := serializer.Decode(data.Bytes(), nil, nil)
+	if err != nil {
+		t.Errorf("unable to decode %s from protobuf: %v", gvk.Kind, err)
+		return true
+	}
+	decoded.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+	if !equality.Semantic.DeepEqual(object, decoded) {
+		t.Errorf("%s changed after a protobuf round trip:\n%s", gvk.Kind, diff.ObjectReflectDiff(object, decoded))
+	}
+	return true
+}
+
+// checkDeepCopy checks that a deep copy equals the object, and that it is left unchanged when every value of the
+// object is modified afterwards.
+func checkDeepCopy(t *testing.T, gvk schema.GroupVersionKind, object runtime.Object) {
+	t.Helper()
+	copied := object.DeepCopyObject()
+	if !equality.Semantic.DeepEqual(object, copied) {
+		t.Errorf("%s changed after a deep copy:\n%s", gvk.Kind, diff.ObjectReflectDiff(object, copied))
+		return
+	}
+	if reflect.ValueOf(object).Pointer() == reflect.ValueOf(copied).Pointer() {
+		t.Errorf("the deep copy of %s is the object itself", gvk.Kind)
+		return
+	}
+
+	before, err := json.Marshal(copied)
+	if err != nil {
+		t.Errorf("unable to encode %s to JSON: %v", gvk.Kind, err)
+		return
+	}
+	fuzzer.ValueFuzz(object)
+	after, _ := json.Marshal(copied)
+	if string(before) != string(after) {
+		t.Errorf("the deep copy of %s shares memory with the object, it changed with the object:\n%s\n%s", gvk.Kind, before, after)
+	}
+}
+
+// apiKinds returns the kinds registered in the scheme, sorted, without those of the meta/v1 package.
+func apiKinds(scheme *runtime.Scheme) []schema.GroupVersionKind {
+	metaPackage := reflect.TypeOf(metav1.ObjectMeta{}).PkgPath()
+	var kinds []schema.GroupVersionKind
+	for gvk, goType := range scheme.AllKnownTypes() {
+		if goType.PkgPath() == metaPackage {
+			continue
+		}
+		kinds = append(kinds, gvk)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i].String() < kinds[j].String()
+	})
+	return kinds
+}
+
+// seed returns the seed of the checks and logs it.
+func (o Options) seed(t *testing.T) int64 {
+	seed := o.Seed
+	if value := os.Getenv(SeedEnv); value != "" {
+		var err error
+		if seed, err = strconv.ParseInt(value, 10, 64); err != nil {
+			t.Fatalf("invalid %s: %v", SeedEnv, err)
+		}
+	}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	t.Logf("round-trip seed: %d, set %s=%d to reproduce", seed, SeedEnv, seed)
+	return seed
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package buildnudge computes the build nudge graph of Components: a successful build of a Component nudges
+// the Components listed in its spec.build-nudges-ref, which are in turn nudged by it (status.build-nudged-by).
+package buildnudge
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+)
+
+// MissingReference is a build nudge reference to a Component that does not exist
+type MissingReference struct {
+	// Component is the name of the Component with the reference
+	Component string
+
+	// Reference is the name of the referenced Component
+	Reference string
+}
+
+// CycleError is returned when the build nudge graph has cycles and therefore no rebuild order
+type CycleError struct {
+	// Cycles are the cycles of the graph, see Graph.Cycles
+	Cycles [][]string
+}
+
+func (e *CycleError) Error() string {
+	cycles := make([]string, 0, len(e.Cycles))
+	for _, cycle := range e.Cycles {
+		cycles = append(cycles, strings.Join(cycle, " -> "))
+	}
+	return fmt.Sprintf("build nudges have cycles: %s", strings.Join(cycles, ", "))
+}
+
+// Graph is the build nudge graph of a set of Components, with an edge from each Component to the Components it nudges.
+// Components are identified by name.
+type Graph struct {
+	components []string
+	nudges     map[string][]string
+	nudgedBy   map[string][]string
+	missing    []MissingReference
+}
+
+// New builds the build nudge graph of the Components in the list.
+// References to Components which are not in the list are left out of the graph and reported by MissingReferences.
+func New(list *v1alpha1.ComponentList) *Graph {
+	g := &Graph{
+		nudges:   map[string][]string{},
+		nudgedBy: map[string][]string{},
+	}
+
+	exists := map[string]bool{}
+	for _, component := range list.Items {
+		if !exists[component.Name] {
+			exists[component.Name] = true
+			g.components = append(g.components, component.Name)
+		}
+	}
+	sort.Strings(g.components)
+
+	for _, component := range list.Items {
+		for _, reference := range component.Spec.BuildNudgesRef {
+			if !exists[reference] {
+				g.missing = append(g.missing, MissingReference{Component: component.Name, Reference: reference})
+				continue
+			}
+			g.nudges[component.Name] = appendUnique(g.nudges[component.Name], reference)
+			g.nudgedBy[reference] = appendUnique(g.nudgedBy[reference], component.Name)
+		}
+	}
+	for _, edges := range []map[string][]string{g.nudges, g.nudgedBy} {
+		for _, names := range edges {
+			sort.Strings(names)
+		}
+	}
+	sort.Slice(g.missing, func(i, j int) bool {
+		if g.missing[i].Component != g.missing[j].Component {
+			return g.missing[i].Component < g.missing[j].Component
+		}
+		return g.missing[i].Reference < g.missing[j].Reference
+	})
+	return g
+}
+
+// Components returns the sorted names of the Components in the graph.
+func (g *Graph) Components() []string {
+	return append([]string{}, g.components...)
+}
+
+// Nudges returns the sorted names of the Components nudged by the given Component.
+func (g *Graph) Nudges(component string) []string {
+	return append([]string{}, g.nudges[component]...)
+}
+
+// NudgedBy returns the sorted names of the Components nudging the given Component, which is the value
+// of its status.build-nudged-by.
+func (g *Graph) NudgedBy(component string) []string {
+	return append([]string{}, g.nudgedBy[component]...)
+}
+
+// BuildNudgedBy returns the status.build-nudged-by value of every Component in the graph.
+// Components which are not nudged by any Component are not included.
+func (g *Graph) BuildNudgedBy() map[string][]string {
+	buildNudgedBy := make(map[string][]string, len(g.nudgedBy))
+	for component := range g.nudgedBy {
+		buildNudgedBy[component] = g.NudgedBy(component)
+	}
+	return buildNudgedBy
+}
+
+// SetBuildNudgedBy sets status.build-nudged-by of every Component in the list from the graph.
+func (g *Graph) SetBuildNudgedBy(list *v1alpha1.ComponentList) {
+	for i := range list.Items {
+		nudgedBy := g.nudgedBy[list.Items[i].Name]
+		if len(nudgedBy) == 0 {
+			list.Items[i].Status.BuildNudgedBy = nil
+			continue
+		}
+		list.Items[i].Status.BuildNudgedBy = append([]string{}, nudgedBy...)
+	}
+}
+
+// MissingReferences returns the build nudge references to Components which do not exist, sorted by Component.
+func (g *Graph) MissingReferences() []MissingReference {
+	return append([]MissingReference{}, g.missing...)
+}
+
+// Cycles returns the cycles of the graph. Each cycle is a path starting and ending with the same Component,
+// the smallest name of the cycle; a Component nudging itself is the cycle [name, name].
+// There is one cycle per strongly connected set of Components, and the cycles are sorted.
+func (g *Graph) Cycles() [][]string {
+	var cycles [][]string
+	for _, scc := range g.stronglyConnected() {
+		if len(scc) == 1 && !contains(g.nudges[scc[0]], scc[0]) {
+			continue
+		}
+		cycles = append(cycles, g.cycleWithin(scc))
+	}
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i][0] < cycles[j][0]
+	})
+	return cycles
+}
+
+// TopologicalOrder returns the names of all the Components in the order they should be rebuilt:
+// each Component comes before the Components it nudges. Ties are broken by name, so the order is deterministic.
+// A *CycleError is returned if the graph has cycles.
+func (g *Graph) TopologicalOrder() ([]string, error) {
+	return g.order(g.components)
+}
+
+// RebuildOrder returns the names of the Components which are nudged, directly or not, by a build of the given
+// Component, in the order they should be rebuilt. The given Component itself is not included.
+// A *CycleError is returned if these Components have cycles.
+func (g *Graph) RebuildOrder(component string) ([]string, error) {
+	reachable := map[string]bool{}
+	queue := []string{component}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range g.nudges[current] {
+			if !reachable[next] {
+				reachable[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	var subset []string
+	for _, name := range g.components {
+		if reachable[name] && name != component {
+			subset = append(subset, name)
+		}
+	}
+	if reachable[component] {
+		return nil, &CycleError{Cycles: g.Cycles()}
+	}
+	return g.order(subset)
+}
+
+// Path returns the shortest path of build nudges from one Component to another, including both of them,
+// or nil if builds of the first Component never nudge the second one.
+func (g *Graph) Path(from, to string) []string {
+	previous := map[string]string{}
+	queue := []string{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range g.nudges[current] {
+			if _, seen := previous[next]; seen {
+				continue
+			}
+			previous[next] = current
+			if next == to {
+				path := []string{to}
+				for name := current; name != from; name = previous[name] {
+					path = append([]string{name}, path...)
+				}
+				return append([]string{from}, path...)
+			}
+			queue = append(queue, next)
+		}
+	}
+	return nil
+}
+
+// order sorts the given Components topologically with Kahn's algorithm, considering only the edges between them.
+func (g *Graph) order(components []string) ([]string, error) {
+	inSubset := make(map[string]bool, len(components))
+	for _, name := range components {
+		inSubset[name] = true
+	}
+	inDegree := make(map[string]int, len(components))
+	for _, name := range components {
+		for _, nudger := range g.nudgedBy[name] {
+			if inSubset[nudger] {
+				inDegree[name]++
+			}
+		}
+	}
+
+	var ready []string
+	for _, name := range components {
+		if inDegree[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	ordered := make([]string, 0, len(components))
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		current := ready[0]
+		ready = ready[1:]
+		ordered = append(ordered, current)
+		for _, next := range g.nudges[current] {
+			if !inSubset[next] {
+				continue
+			}
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				ready = append(ready, next)
+			}
+		}
+	}
+
+	if len(ordered) != len(components) {
+		return nil, &CycleError{Cycles: g.Cycles()}
+	}
+	return ordered, nil
+}
+
+// stronglyConnected returns the strongly connected sets of Components of the graph, using Tarjan's algorithm.
+// Each set is sorted by name.
+func (g *Graph) stronglyConnected() [][]string {
+	index := 0
+	indices := map[string]int{}
+	lowLinks := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var sccs [][]string
+
+	var visit func(string)
+	visit = func(name string) {
+		indices[name] = index
+		lowLinks[name] = index
+		index++
+		stack = append(stack, name)
+		onStack[name] = true
+
+		for _, next := range g.nudges[name] {
+			if _, visited := indices[next]; !visited {
+				visit(next)
+				lowLinks[name] = minInt(lowLinks[name], lowLinks[next])
+			} else if onStack[next] {
+				lowLinks[name] = minInt(lowLinks[name], indices[next])
+			}
+		}
+
+		if lowLinks[name] == indices[name] {
+			var scc []string
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				scc = append(scc, top)
+				if top == name {
+					break
+				}
+			}
+			sort.Strings(scc)
+			sccs = append(sccs, scc)
+		}
+	}
+
+	for _, name := range g.components {
+		if _, visited := indices[name]; !visited {
+			visit(name)
+		}
+	}
+	return sccs
+}
+
+// cycleWithin returns a cycle through the smallest Component of a strongly connected set, using a breadth-first
+// search restricted to the set so that the shortest cycle is found.
+func (g *Graph) cycleWithin(scc []string) []string {
+	start := scc[0]
+	inSCC := make(map[string]bool, len(scc))
+	for _, name := range scc {
+		inSCC[name] = true
+	}
+
+	previous := map[string]string{}
+	queue := []string{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range g.nudges[current] {
+			if !inSCC[next] {
+				continue
+			}
+			if next == start {
+				cycle := []string{start}
+				for name := current; name != start; name = previous[name] {
+					cycle = append(cycle, name)
+				}
+				// The path was collected backwards from current
+				for i, j := 1, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return append(cycle, start)
+			}
+			if _, seen := previous[next]; !seen {
+				previous[next] = current
+				queue = append(queue, next)
+			}
+		}
+	}
+	return []string{start, start}
+}
+
+func appendUnique(names []string, name string) []string {
+	if contains(names, name) {
+		return names
+	}
+	return append(names, name)
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
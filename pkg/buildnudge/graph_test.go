@@ -0,0 +1,188 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildnudge_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/application-api/pkg/buildnudge"
+)
+
+// component returns a Component of the application app nudging the given Components
+func component(name, app string, nudges ...string) v1alpha1.Component {
+	return v1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       v1alpha1.ComponentSpec{Application: app, BuildNudgesRef: nudges},
+	}
+}
+
+// graph builds the graph of Components given as name and nudged Components
+func graph(edges map[string][]string) *buildnudge.Graph {
+	list := &v1alpha1.ComponentList{}
+	for name, nudges := range edges {
+		list.Items = append(list.Items, component(name, "app", nudges...))
+	}
+	return buildnudge.New(list)
+}
+
+func TestCycles(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges map[string][]string
+		want  [][]string
+	}{
+		{
+			name:  "no cycle",
+			edges: map[string][]string{"a": {"b", "c"}, "b": {"c"}, "c": nil},
+			want:  nil,
+		},
+		{
+			name:  "self-loop",
+			edges: map[string][]string{"a": {"a", "b"}, "b": nil},
+			want:  [][]string{{"a", "a"}},
+		},
+		{
+			name:  "several strongly connected sets",
+			edges: map[string][]string{"a": {"b"}, "b": {"a", "c"}, "c": {"d"}, "d": {"e"}, "e": {"c"}, "f": {"a"}},
+			want:  [][]string{{"a", "b", "a"}, {"c", "d", "e", "c"}},
+		},
+		{
+			name:  "shortest cycle of a strongly connected set",
+			edges: map[string][]string{"a": {"b", "d"}, "b": {"c"}, "c": {"a"}, "d": {"a"}},
+			want:  [][]string{{"a", "d", "a"}},
+		},
+		{
+			name:  "cycle starting with the smallest name",
+			edges: map[string][]string{"z": {"y"}, "y": {"x"}, "x": {"z"}},
+			want:  [][]string{{"x", "z", "y", "x"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := graph(tt.edges).Cycles(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Cycles() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopologicalOrder(t *testing.T) {
+	order, err := graph(map[string][]string{"d": nil, "c": {"d"}, "b": {"c"}, "a": {"c"}, "e": nil}).TopologicalOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("TopologicalOrder() = %q, want %q", order, want)
+	}
+
+	_, err = graph(map[string][]string{"a": {"b"}, "b": {"a"}, "c": {"c"}}).TopologicalOrder()
+	var cycleErr *buildnudge.CycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("TopologicalOrder() error = %v, want a *CycleError", err)
+	}
+	if want := "build nudges have cycles: a -> b -> a, c -> c"; err.Error() != want {
+		t.Errorf("TopologicalOrder() error = %q, want %q", err, want)
+	}
+}
+
+func TestRebuildOrder(t *testing.T) {
+	g := graph(map[string][]string{"a": {"c", "b"}, "b": {"d"}, "c": {"d"}, "d": nil, "e": {"a"}})
+	tests := []struct {
+		component string
+		want      []string
+	}{
+		{component: "a", want: []string{"b", "c", "d"}},
+		{component: "e", want: []string{"a", "b", "c", "d"}},
+		{component: "d", want: []string{}},
+		{component: "unknown", want: []string{}},
+	}
+	for _, tt := range tests {
+		got, err := g.RebuildOrder(tt.component)
+		if err != nil {
+			t.Errorf("RebuildOrder(%q) returned error: %v", tt.component, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("RebuildOrder(%q) = %q, want %q", tt.component, got, tt.want)
+		}
+	}
+
+	cyclic := graph(map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"b"}, "d": {"d", "e"}, "e": nil})
+	for _, name := range []string{"a", "b", "d"} {
+		if _, err := cyclic.RebuildOrder(name); err == nil {
+			t.Errorf("RebuildOrder(%q) returned no error", name)
+		}
+	}
+	if _, err := cyclic.RebuildOrder("e"); err != nil {
+		t.Errorf("RebuildOrder(%q) returned error: %v", "e", err)
+	}
+}
+
+func TestPath(t *testing.T) {
+	g := graph(map[string][]string{"a": {"b", "c"}, "b": {"d"}, "c": {"e"}, "d": {"e"}, "e": nil})
+	tests := []struct {
+		from, to string
+		want     []string
+	}{
+		{from: "a", to: "e", want: []string{"a", "c", "e"}},
+		{from: "a", to: "b", want: []string{"a", "b"}},
+		{from: "b", to: "e", want: []string{"b", "d", "e"}},
+		{from: "e", to: "a", want: nil},
+		{from: "a", to: "a", want: nil},
+	}
+	for _, tt := range tests {
+		if got := g.Path(tt.from, tt.to); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Path(%q, %q) = %q, want %q", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestMissingReferences(t *testing.T) {
+	g := graph(map[string][]string{"b": {"y", "a"}, "a": {"x"}})
+	want := []buildnudge.MissingReference{{Component: "a", Reference: "x"}, {Component: "b", Reference: "y"}}
+	if got := g.MissingReferences(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MissingReferences() = %+v, want %+v", got, want)
+	}
+	if got := g.Nudges("a"); len(got) != 0 {
+		t.Errorf("Nudges(%q) = %q, want none", "a", got)
+	}
+}
+
+func TestSetBuildNudgedBy(t *testing.T) {
+	list := &v1alpha1.ComponentList{Items: []v1alpha1.Component{
+		component("a", "app", "c", "c"),
+		component("b", "app", "c"),
+		component("c", "app"),
+	}}
+	list.Items[0].Status.BuildNudgedBy = []string{"stale"}
+	g := buildnudge.New(list)
+
+	if want := map[string][]string{"c": {"a", "b"}}; !reflect.DeepEqual(g.BuildNudgedBy(), want) {
+		t.Errorf("BuildNudgedBy() = %q, want %q", g.BuildNudgedBy(), want)
+	}
+	g.SetBuildNudgedBy(list)
+	for i, want := range [][]string{nil, nil, {"a", "b"}} {
+		if got := list.Items[i].Status.BuildNudgedBy; !reflect.DeepEqual(got, want) {
+			t.Errorf("component %s: build-nudged-by = %q, want %q", list.Items[i].Name, got, want)
+		}
+	}
+}
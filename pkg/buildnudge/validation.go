@@ -0,0 +1,79 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildnudge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateComponent checks the build nudges of a Component being created or updated against the existing
+// Components of its namespace, and is meant to be called from an admission webhook. It rejects duplicate
+// references, references to the Component itself, to Components which do not exist or which belong to another
+// Application, and references that would create a cycle of build nudges. Each error names the offending
+// spec.build-nudges-ref entry.
+//
+// Applications are only compared when both Components set spec.application.
+// The existing Components may include a previous version of the Component, which is ignored.
+func ValidateComponent(component *v1alpha1.Component, existing []v1alpha1.Component) error {
+	byName := map[string]*v1alpha1.Component{}
+	list := &v1alpha1.ComponentList{}
+	for i := range existing {
+		if existing[i].Name == component.Name {
+			continue
+		}
+		byName[existing[i].Name] = &existing[i]
+		list.Items = append(list.Items, existing[i])
+	}
+	list.Items = append(list.Items, *component)
+	graph := New(list)
+
+	var errs field.ErrorList
+	refsPath := field.NewPath("spec").Child("build-nudges-ref")
+	seen := map[string]bool{}
+	for i, reference := range component.Spec.BuildNudgesRef {
+		path := refsPath.Index(i)
+		if seen[reference] {
+			errs = append(errs, field.Duplicate(path, reference))
+			continue
+		}
+		seen[reference] = true
+
+		if reference == component.Name {
+			errs = append(errs, field.Invalid(path, reference, v1alpha1.BuildNudgeSelfReferenceError))
+			continue
+		}
+		nudged, ok := byName[reference]
+		if !ok {
+			errs = append(errs, field.Invalid(path, reference, fmt.Sprintf(v1alpha1.BuildNudgeNotFoundError, reference)))
+			continue
+		}
+		if component.Spec.Application != "" && nudged.Spec.Application != "" && component.Spec.Application != nudged.Spec.Application {
+			errs = append(errs, field.Invalid(path, reference, fmt.Sprintf(v1alpha1.BuildNudgeApplicationError,
+				reference, nudged.Spec.Application, component.Spec.Application)))
+			continue
+		}
+		if cycle := graph.Path(reference, component.Name); cycle != nil {
+			cycle = append([]string{component.Name}, cycle...)
+			errs = append(errs, field.Invalid(path, reference, fmt.Sprintf(v1alpha1.BuildNudgeCycleError, strings.Join(cycle, " -> "))))
+		}
+	}
+	return errs.ToAggregate()
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildnudge_test
+
+import (
+	"testing"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/application-api/pkg/buildnudge"
+)
+
+func TestValidateComponent(t *testing.T) {
+	existing := []v1alpha1.Component{
+		component("a", "app", "b"),
+		component("b", "app", "c"),
+		component("c", "app"),
+		component("other", "other-app"),
+		component("unassigned", ""),
+		component("new", "app", "a"),
+	}
+	tests := []struct {
+		name      string
+		component v1alpha1.Component
+		want      string
+	}{
+		{
+			name:      "valid",
+			component: component("new", "app", "a", "unassigned"),
+		},
+		{
+			name:      "update of an existing component",
+			component: component("a", "app", "c"),
+		},
+		{
+			name:      "duplicate reference",
+			component: component("new", "app", "a", "a"),
+			want:      `spec.build-nudges-ref[1]: Duplicate value: "a"`,
+		},
+		{
+			name:      "self reference",
+			component: component("new", "app", "new"),
+			want:      `spec.build-nudges-ref[0]: Invalid value: "new": a component cannot nudge itself`,
+		},
+		{
+			name:      "missing reference",
+			component: component("new", "app", "a", "missing"),
+			want:      `spec.build-nudges-ref[1]: Invalid value: "missing": component missing does not exist`,
+		},
+		{
+			name:      "reference to another application",
+			component: component("new", "app", "other"),
+			want:      `spec.build-nudges-ref[0]: Invalid value: "other": component other belongs to application other-app, not to application app`,
+		},
+		{
+			name:      "cycle",
+			component: component("c", "app", "a"),
+			want:      `spec.build-nudges-ref[0]: Invalid value: "a": build nudges would form a cycle: c -> a -> b -> c`,
+		},
+		{
+			name:      "several errors",
+			component: component("c", "app", "c", "b"),
+			want: `[spec.build-nudges-ref[0]: Invalid value: "c": a component cannot nudge itself, ` +
+				`spec.build-nudges-ref[1]: Invalid value: "b": build nudges would form a cycle: c -> b -> c]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := buildnudge.ValidateComponent(&tt.component, existing)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("ValidateComponent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package buildtrigger decides which versions of which Components to build when files of a git repository
+// change: a version is built when a changed file matches its spec.source.versions[].include-paths and none of
+// its exclude-paths, so that Components of a monorepo are only built when their own files change.
+package buildtrigger
+
+import (
+	"errors"
+	"path"
+	"strings"
+)
+
+// doubleStar is the pattern element matching any number of path elements
+const doubleStar = "**"
+
+// MatchPath reports whether a path, relative to the root of the repository, matches a pattern: the pattern
+// matches either the path or one of its parent directories. Each element of the pattern is matched with the
+// syntax of path.Match, except '**' which matches any number of elements.
+func MatchPath(pattern, name string) (bool, error) {
+	if err := ValidatePattern(pattern); err != nil {
+		return false, err
+	}
+	return matchElements(split(pattern), split(name)), nil
+}
+
+// ValidatePattern checks that a path pattern is not empty, relative to the root of the repository and
+// syntactically valid.
+func ValidatePattern(pattern string) error {
+	cleaned := clean(pattern)
+	if cleaned == "" {
+		return errors.New("pattern is empty")
+	}
+	for _, element := range split(cleaned) {
+		if element == ".." {
+			return errors.New("pattern must not refer to a parent directory")
+		}
+		if _, err := path.Match(element, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchElements matches the elements of a path against those of a pattern. The path matches once all the
+// elements of the pattern are matched, even if it has elements left since it is then in a matched directory.
+func matchElements(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == doubleStar {
+			for i := 0; i <= len(name); i++ {
+				if matchElements(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		// The pattern was validated
+		if matched, _ := path.Match(pattern[0], name[0]); !matched {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return true
+}
+
+// clean returns a path or pattern relative to the root of the repository, without leading "./" or "/",
+// or an empty string for the root itself.
+func clean(name string) string {
+	name = strings.TrimLeft(path.Clean(name), "/")
+	if name == "." {
+		return ""
+	}
+	return name
+}
+
+// split returns the elements of a path or pattern.
+func split(name string) []string {
+	if name = clean(name); name == "" {
+		return nil
+	}
+	return strings.Split(name, "/")
+}
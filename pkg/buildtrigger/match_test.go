@@ -0,0 +1,89 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildtrigger_test
+
+import (
+	"testing"
+
+	"github.com/konflux-ci/application-api/pkg/buildtrigger"
+)
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		// Exact paths and directory prefixes
+		{pattern: "api/main.go", name: "api/main.go", want: true},
+		{pattern: "api", name: "api/main.go", want: true},
+		{pattern: "api", name: "api/v1/types.go", want: true},
+		{pattern: "./api/", name: "api/main.go", want: true},
+		{pattern: "/api", name: "api/main.go", want: true},
+		{pattern: "api", name: "apis/main.go", want: false},
+		{pattern: "api/v1", name: "api/main.go", want: false},
+		// Wildcards within an element
+		{pattern: "*.go", name: "main.go", want: true},
+		{pattern: "*.go", name: "api/main.go", want: false},
+		{pattern: "api/*.go", name: "api/main.go", want: true},
+		{pattern: "api/*", name: "api/v1/types.go", want: true},
+		// ** at the start
+		{pattern: "**/*.go", name: "main.go", want: true},
+		{pattern: "**/*.go", name: "api/v1/types.go", want: true},
+		{pattern: "**/*.go", name: "api/README.md", want: false},
+		// ** in the middle
+		{pattern: "api/**/types.go", name: "api/types.go", want: true},
+		{pattern: "api/**/types.go", name: "api/v1/alpha/types.go", want: true},
+		{pattern: "api/**/types.go", name: "web/v1/types.go", want: false},
+		// ** at the end
+		{pattern: "api/**", name: "api/main.go", want: true},
+		{pattern: "api/**", name: "api/v1/types.go", want: true},
+		{pattern: "api/**", name: "web/main.go", want: false},
+		{pattern: "**", name: "anything/at/all", want: true},
+	}
+	for _, tt := range tests {
+		got, err := buildtrigger.MatchPath(tt.pattern, tt.name)
+		if err != nil {
+			t.Errorf("MatchPath(%q, %q) returned error: %v", tt.pattern, tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("MatchPath(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		valid   bool
+	}{
+		{pattern: "api/**/*.go", valid: true},
+		{pattern: "api/[a-z]*", valid: true},
+		{pattern: "", valid: false},
+		{pattern: "/", valid: false},
+		{pattern: ".", valid: false},
+		{pattern: "../api", valid: false},
+		{pattern: "api/../../web", valid: false},
+		{pattern: "api/[", valid: false},
+	}
+	for _, tt := range tests {
+		if err := buildtrigger.ValidatePattern(tt.pattern); (err == nil) != tt.valid {
+			t.Errorf("ValidatePattern(%q) = %v, want valid %v", tt.pattern, err, tt.valid)
+		}
+	}
+}
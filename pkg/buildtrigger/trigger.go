@@ -0,0 +1,151 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildtrigger
+
+import (
+	"fmt"
+	"strings"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/application-api/pkg/gitutil"
+)
+
+// branchRefPrefix is the prefix of the git references of branches, as in refs/heads/main
+const branchRefPrefix = "refs/heads/"
+
+// Change is a push of commits to a branch of a git repository
+type Change struct {
+	// URL is the URL of the git repository, in any of the forms accepted by gitutil.Parse
+	URL string
+
+	// Revision is the branch the commits were pushed to, with or without the refs/heads/ prefix
+	Revision string
+
+	// Files are the paths of the files changed by the commits, relative to the root of the repository.
+	// When empty, the changed files are unknown and every version built from the branch is built.
+	Files []string
+}
+
+// Build is a version of a Component to build
+type Build struct {
+	// Component is the name of the Component
+	Component string
+
+	// Version is the name of the version, as in the spec of the Component
+	Version string
+}
+
+// VersionMatcher decides whether changes of files trigger builds of a version, from its include-paths and
+// exclude-paths
+type VersionMatcher struct {
+	include []string
+	exclude []string
+}
+
+// NewVersionMatcher returns the matcher of a version. When the version has no include-paths, its context
+// directory is included, or the whole repository if it has none. An error is returned if a pattern is invalid.
+func NewVersionMatcher(version *v1alpha1.ComponentVersion) (*VersionMatcher, error) {
+	matcher := &VersionMatcher{include: version.IncludePaths, exclude: version.ExcludePaths}
+	if len(matcher.include) == 0 {
+		if context := clean(version.Context); context != "" {
+			matcher.include = []string{context}
+		}
+	}
+	for _, patterns := range [][]string{matcher.include, matcher.exclude} {
+		for _, pattern := range patterns {
+			if err := ValidatePattern(pattern); err != nil {
+				return nil, fmt.Errorf("%q: %w", pattern, err)
+			}
+		}
+	}
+	return matcher, nil
+}
+
+// Matches returns whether a change of the files triggers a build: any of them is included and not excluded.
+func (m *VersionMatcher) Matches(files []string) bool {
+	for _, file := range files {
+		if m.MatchesFile(file) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesFile returns whether a change of the file triggers a build: it is included and not excluded.
+func (m *VersionMatcher) MatchesFile(file string) bool {
+	elements := split(file)
+	return (len(m.include) == 0 || matchAny(m.include, elements)) && !matchAny(m.exclude, elements)
+}
+
+// Builds returns the versions of the Components to build for a change, in the order of the list and of the
+// versions in the spec of each Component. A version is built when the Component is built from the repository
+// of the change, the version from its branch, its builds are not skipped and the change has files matched by
+// the version, see VersionMatcher.
+//
+// Components whose repository URL or path patterns are invalid are left out, and reported in the returned
+// error along with the builds of the other Components.
+func Builds(list *v1alpha1.ComponentList, change Change) ([]Build, error) {
+	repository, err := gitutil.Normalize(change.URL)
+	if err != nil {
+		return nil, err
+	}
+	revision := strings.TrimPrefix(change.Revision, branchRefPrefix)
+
+	var builds []Build
+	var errs []error
+	for i := range list.Items {
+		component := &list.Items[i]
+		if component.Spec.Source.GitURL == "" {
+			continue
+		}
+		componentRepository, err := gitutil.Normalize(component.Spec.Source.GitURL)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("component %s: %w", component.Name, err))
+			continue
+		}
+		if componentRepository != repository {
+			continue
+		}
+		for j := range component.Spec.Source.Versions {
+			version := &component.Spec.Source.Versions[j]
+			if version.SkipBuilds || version.Revision != revision {
+				continue
+			}
+			matcher, err := NewVersionMatcher(version)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("component %s version %s: %w", component.Name, version.Name, err))
+				continue
+			}
+			if len(change.Files) == 0 || matcher.Matches(change.Files) {
+				builds = append(builds, Build{Component: component.Name, Version: version.Name})
+			}
+		}
+	}
+	return builds, utilerrors.NewAggregate(errs)
+}
+
+// matchAny returns whether the elements of a path match any of the validated patterns.
+func matchAny(patterns []string, elements []string) bool {
+	for _, pattern := range patterns {
+		if matchElements(split(pattern), elements) {
+			return true
+		}
+	}
+	return false
+}
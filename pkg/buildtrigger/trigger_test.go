@@ -0,0 +1,234 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildtrigger_test
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/application-api/pkg/buildtrigger"
+)
+
+func TestVersionMatcher(t *testing.T) {
+	tests := []struct {
+		name    string
+		version v1alpha1.ComponentVersion
+		files   []string
+		want    bool
+	}{
+		{
+			name:    "included",
+			version: v1alpha1.ComponentVersion{IncludePaths: []string{"api/**"}},
+			files:   []string{"web/index.html", "api/main.go"},
+			want:    true,
+		},
+		{
+			name:    "not included",
+			version: v1alpha1.ComponentVersion{IncludePaths: []string{"api/**"}},
+			files:   []string{"web/index.html"},
+			want:    false,
+		},
+		{
+			name:    "exclude overrides include",
+			version: v1alpha1.ComponentVersion{IncludePaths: []string{"api"}, ExcludePaths: []string{"**/*.md"}},
+			files:   []string{"api/README.md"},
+			want:    false,
+		},
+		{
+			name:    "excluded and included files",
+			version: v1alpha1.ComponentVersion{IncludePaths: []string{"api"}, ExcludePaths: []string{"**/*.md"}},
+			files:   []string{"api/README.md", "api/main.go"},
+			want:    true,
+		},
+		{
+			name:    "context directory without include-paths",
+			version: v1alpha1.ComponentVersion{Context: "./api/"},
+			files:   []string{"api/main.go"},
+			want:    true,
+		},
+		{
+			name:    "outside of the context directory without include-paths",
+			version: v1alpha1.ComponentVersion{Context: "api"},
+			files:   []string{"web/index.html"},
+			want:    false,
+		},
+		{
+			name:    "include-paths take precedence over the context directory",
+			version: v1alpha1.ComponentVersion{Context: "api", IncludePaths: []string{"shared"}},
+			files:   []string{"api/main.go"},
+			want:    false,
+		},
+		{
+			name:    "whole repository without include-paths or context",
+			version: v1alpha1.ComponentVersion{ExcludePaths: []string{"docs"}},
+			files:   []string{"web/index.html"},
+			want:    true,
+		},
+		{
+			name:    "excluded without include-paths or context",
+			version: v1alpha1.ComponentVersion{ExcludePaths: []string{"docs"}},
+			files:   []string{"docs/index.md"},
+			want:    false,
+		},
+		{
+			name:    "no files",
+			version: v1alpha1.ComponentVersion{},
+			files:   nil,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := buildtrigger.NewVersionMatcher(&tt.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := matcher.Matches(tt.files); got != tt.want {
+				t.Errorf("Matches(%q) = %v, want %v", tt.files, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewVersionMatcherInvalidPattern(t *testing.T) {
+	for _, version := range []v1alpha1.ComponentVersion{
+		{IncludePaths: []string{"../api"}},
+		{ExcludePaths: []string{"api/["}},
+	} {
+		if _, err := buildtrigger.NewVersionMatcher(&version); err == nil {
+			t.Errorf("NewVersionMatcher(%+v) returned no error", version)
+		}
+	}
+}
+
+func TestBuilds(t *testing.T) {
+	component := func(name, url string, versions ...v1alpha1.ComponentVersion) v1alpha1.Component {
+		return v1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha1.ComponentSpec{Source: v1alpha1.ComponentSource{ComponentSourceUnion: v1alpha1.ComponentSourceUnion{
+				GitURL:   url,
+				Versions: versions,
+			}}},
+		}
+	}
+	list := &v1alpha1.ComponentList{Items: []v1alpha1.Component{
+		component("api", "https://github.com/org/repo",
+			v1alpha1.ComponentVersion{Name: "main", Revision: "main", IncludePaths: []string{"api"}},
+			v1alpha1.ComponentVersion{Name: "devel", Revision: "devel", IncludePaths: []string{"api"}},
+		),
+		component("web", "git@github.com:org/repo.git",
+			v1alpha1.ComponentVersion{Name: "main", Revision: "main", Context: "web"},
+			v1alpha1.ComponentVersion{Name: "skipped", Revision: "main", SkipBuilds: true},
+		),
+		component("other", "https://github.com/org/other",
+			v1alpha1.ComponentVersion{Name: "main", Revision: "main"},
+		),
+		component("image", ""),
+	}}
+
+	tests := []struct {
+		name   string
+		change buildtrigger.Change
+		want   []buildtrigger.Build
+	}{
+		{
+			name:   "matched files",
+			change: buildtrigger.Change{URL: "https://github.com/org/repo.git", Revision: "main", Files: []string{"api/main.go"}},
+			want:   []buildtrigger.Build{{Component: "api", Version: "main"}},
+		},
+		{
+			name:   "repository URLs are normalized",
+			change: buildtrigger.Change{URL: "ssh://git@github.com/org/repo/", Revision: "main", Files: []string{"web/index.html"}},
+			want:   []buildtrigger.Build{{Component: "web", Version: "main"}},
+		},
+		{
+			name:   "repository paths are case-insensitive on GitHub",
+			change: buildtrigger.Change{URL: "https://github.com/Org/Repo", Revision: "main", Files: []string{"api/main.go"}},
+			want:   []buildtrigger.Build{{Component: "api", Version: "main"}},
+		},
+		{
+			name:   "refs/heads/ prefix",
+			change: buildtrigger.Change{URL: "https://github.com/org/repo", Revision: "refs/heads/devel", Files: []string{"api/main.go"}},
+			want:   []buildtrigger.Build{{Component: "api", Version: "devel"}},
+		},
+		{
+			name:   "no files builds every version of the branch except skipped ones",
+			change: buildtrigger.Change{URL: "https://github.com/org/repo", Revision: "main"},
+			want:   []buildtrigger.Build{{Component: "api", Version: "main"}, {Component: "web", Version: "main"}},
+		},
+		{
+			name:   "unmatched files",
+			change: buildtrigger.Change{URL: "https://github.com/org/repo", Revision: "main", Files: []string{"README.md"}},
+			want:   nil,
+		},
+		{
+			name:   "other branch",
+			change: buildtrigger.Change{URL: "https://github.com/org/repo", Revision: "feature", Files: []string{"api/main.go"}},
+			want:   nil,
+		},
+		{
+			name:   "other repository",
+			change: buildtrigger.Change{URL: "https://github.com/org/other", Revision: "main", Files: []string{"README.md"}},
+			want:   []buildtrigger.Build{{Component: "other", Version: "main"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildtrigger.Builds(list, tt.change)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Builds() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildsErrors(t *testing.T) {
+	list := &v1alpha1.ComponentList{Items: []v1alpha1.Component{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid-url"},
+			Spec: v1alpha1.ComponentSpec{Source: v1alpha1.ComponentSource{ComponentSourceUnion: v1alpha1.ComponentSourceUnion{
+				GitURL: "not a url",
+			}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid-pattern"},
+			Spec: v1alpha1.ComponentSpec{Source: v1alpha1.ComponentSource{ComponentSourceUnion: v1alpha1.ComponentSourceUnion{
+				GitURL: "https://github.com/org/repo",
+				Versions: []v1alpha1.ComponentVersion{
+					{Name: "bad", Revision: "main", IncludePaths: []string{"../api"}},
+					{Name: "good", Revision: "main"},
+				},
+			}}},
+		},
+	}}
+	builds, err := buildtrigger.Builds(list, buildtrigger.Change{URL: "https://github.com/org/repo", Revision: "main"})
+	if want := []buildtrigger.Build{{Component: "invalid-pattern", Version: "good"}}; !reflect.DeepEqual(builds, want) {
+		t.Errorf("Builds() = %+v, want %+v", builds, want)
+	}
+	if err == nil {
+		t.Fatal("Builds() returned no error")
+	}
+	if _, err := buildtrigger.Builds(list, buildtrigger.Change{URL: "not a url"}); err == nil {
+		t.Error("Builds() returned no error for an invalid change URL")
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildtrigger
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+)
+
+// ValidateComponent checks the include-paths and exclude-paths patterns of the versions of a Component, see
+// ValidatePattern. Each error names the offending pattern.
+func ValidateComponent(component *v1alpha1.Component) error {
+	var errs field.ErrorList
+	versionsPath := field.NewPath("spec", "source", "versions")
+	for i, version := range component.Spec.Source.Versions {
+		path := versionsPath.Index(i)
+		errs = append(errs, validatePatterns(path.Child("include-paths"), version.IncludePaths)...)
+		errs = append(errs, validatePatterns(path.Child("exclude-paths"), version.ExcludePaths)...)
+	}
+	return errs.ToAggregate()
+}
+
+func validatePatterns(path *field.Path, patterns []string) field.ErrorList {
+	var errs field.ErrorList
+	for i, pattern := range patterns {
+		if err := ValidatePattern(pattern); err != nil {
+			errs = append(errs, field.Invalid(path.Index(i), pattern, fmt.Sprintf(v1alpha1.InvalidPathPatternError, err)))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,415 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package detection detects the components of a git repository checked out locally, producing the same
+// ComponentDetectionMap that the component detection service writes to the status of a ComponentDetectionQuery.
+package detection
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+)
+
+const (
+	// DefaultMaxDepth is the default depth, below the root of the repository, up to which context directories are searched
+	DefaultMaxDepth = 3
+
+	// defaultComponentName is used when no usable name can be derived for a component
+	defaultComponentName = "component"
+)
+
+// skippedDirs are never searched for context directories
+var skippedDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+	"target":       true,
+	"dist":         true,
+	"testdata":     true,
+}
+
+// Options configures component detection.
+type Options struct {
+	// URL is the URL of the git repository, set in the GitSource of the detected components.
+	// When Name is not set, the name of the repository is used for a component at the root of the repository.
+	URL string
+
+	// Revision is the git branch, tag or commit id, set in the GitSource of the detected components.
+	Revision string
+
+	// Name is the name used for a component at the root of the repository.
+	Name string
+
+	// MaxDepth is the depth, below the root of the repository, up to which context directories are searched.
+	// Defaults to DefaultMaxDepth.
+	MaxDepth int
+}
+
+// detectedContext is a context directory in which a component was detected
+type detectedContext struct {
+	dir         string
+	devfile     string
+	dockerfile  string
+	language    string
+	projectType string
+	devfileName string
+}
+
+// hasBuildDefinition reports whether the context has a devfile or a Dockerfile, which fully describe how to build the component
+func (c *detectedContext) hasBuildDefinition() bool {
+	return c.devfile != "" || c.dockerfile != ""
+}
+
+// Detect detects the components of the repository checked out in fsys.
+//
+// The subdirectories are searched for devfiles, Dockerfiles and project markers such as package.json, go.mod or
+// pom.xml, and each directory where one is found becomes the context directory of a component. The root of the
+// repository is a component as well if it has a devfile or a Dockerfile, or if it has a project marker and no
+// subdirectory has a component.
+func Detect(fsys fs.FS, options Options) (v1alpha1.ComponentDetectionMap, error) {
+	if options.MaxDepth <= 0 {
+		options.MaxDepth = DefaultMaxDepth
+	}
+
+	root, err := detectContext(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	// The devfile or Dockerfile of the root may be in a subdirectory, such as docker/Dockerfile, which is not a component of its own
+	used := map[string]bool{}
+	if root != nil {
+		for _, file := range []string{root.devfile, root.dockerfile} {
+			if file != "" {
+				used[file] = true
+			}
+		}
+	}
+	contexts, err := detectSubdirectories(fsys, ".", 1, options.MaxDepth, used)
+	if err != nil {
+		return nil, err
+	}
+	if root != nil && (root.hasBuildDefinition() || len(contexts) == 0) {
+		// The root comes first, so that it is named after the repository
+		contexts = append([]*detectedContext{root}, contexts...)
+	}
+
+	detected := v1alpha1.ComponentDetectionMap{}
+	for _, found := range contexts {
+		name := componentName(found, options, detected)
+		detected[name] = found.description(name, options)
+	}
+	return detected, nil
+}
+
+// DetectDir detects the components of the repository checked out in dir.
+func DetectDir(dir string, options Options) (v1alpha1.ComponentDetectionMap, error) {
+	if options.Name == "" && options.URL == "" {
+		if absDir, err := filepath.Abs(dir); err == nil {
+			options.Name = filepath.Base(absDir)
+		}
+	}
+	return Detect(os.DirFS(dir), options)
+}
+
+// DetectBundle detects the components of the repository in a git bundle, at options.Revision if set.
+// The bundle is cloned into a temporary directory with the git command, which must be on the PATH.
+func DetectBundle(ctx context.Context, bundle string, options Options) (v1alpha1.ComponentDetectionMap, error) {
+	var revisions []string
+	if options.Revision != "" {
+		revisions = []string{options.Revision}
+	}
+	return DetectBundleRevisions(ctx, bundle, revisions, options)
+}
+
+// DetectBundleRevisions detects the components of the repository in a git bundle at each of the given revisions,
+// and merges the results with MergeRevisions. options.Revision is ignored. When no revision is given, the
+// default branch of the bundle is used.
+// The bundle is cloned into a temporary directory with the git command, which must be on the PATH. Revisions
+// starting with a dash are rejected, as git would take them for options.
+func DetectBundleRevisions(ctx context.Context, bundle string, revisions []string, options Options) (v1alpha1.ComponentDetectionMap, error) {
+	for _, revision := range revisions {
+		if strings.HasPrefix(revision, "-") {
+			return nil, fmt.Errorf("invalid revision %q: must not start with a dash", revision)
+		}
+	}
+
+	checkout, err := os.MkdirTemp("", "component-detection-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(checkout)
+
+	if err := runGit(ctx, "clone", "--quiet", "--", bundle, checkout); err != nil {
+		return nil, err
+	}
+	if options.Name == "" && options.URL == "" {
+		options.Name = strings.TrimSuffix(filepath.Base(bundle), filepath.Ext(bundle))
+	}
+
+	if len(revisions) == 0 {
+		options.Revision = ""
+		return Detect(os.DirFS(checkout), options)
+	}
+
+	results := make([]v1alpha1.ComponentDetectionMap, 0, len(revisions))
+	for _, revision := range revisions {
+		// Remote branches of the bundle are only available as origin/<branch> in the clone
+		if err := runGit(ctx, "-C", checkout, "checkout", "--quiet", "--force", "-B", revision, "origin/"+revision); err != nil {
+			if err := runGit(ctx, "-C", checkout, "checkout", "--quiet", "--force", "--detach", revision); err != nil {
+				return nil, err
+			}
+		}
+		options.Revision = revision
+		detected, err := Detect(os.DirFS(checkout), options)
+		if err != nil {
+			return nil, fmt.Errorf("unable to detect components in revision %s: %w", revision, err)
+		}
+		results = append(results, detected)
+	}
+	return MergeRevisions(results), nil
+}
+
+// MergeRevisions merges the components detected in several revisions of a repository, given in order of preference.
+// Components detected in the same context directory are the same component: the description from the first
+// revision it was found in is kept, and the proposed versions of all the revisions are combined.
+func MergeRevisions(results []v1alpha1.ComponentDetectionMap) v1alpha1.ComponentDetectionMap {
+	merged := v1alpha1.ComponentDetectionMap{}
+	nameByContext := map[string]string{}
+
+	for _, detected := range results {
+		names := make([]string, 0, len(detected))
+		for name := range detected {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			description := detected[name]
+			contextDir := ""
+			if gitSource := description.ComponentStub.Source.GitSource; gitSource != nil {
+				contextDir = gitSource.Context
+			}
+
+			if mergedName, ok := nameByContext[contextDir]; ok {
+				existing := merged[mergedName]
+				existing.ProposedVersions = appendVersions(existing.ProposedVersions, description.ProposedVersions)
+				merged[mergedName] = existing
+				continue
+			}
+
+			mergedName := name
+			for i := 2; ; i++ {
+				if _, used := merged[mergedName]; !used {
+					break
+				}
+				mergedName = v1alpha1.AppendComponentNameSuffix(name, strconv.Itoa(i))
+			}
+			description.ComponentStub.ComponentName = mergedName
+			nameByContext[contextDir] = mergedName
+			merged[mergedName] = *description.DeepCopy()
+		}
+	}
+	return merged
+}
+
+// appendVersions appends the versions which are not in existing yet, comparing them by name.
+func appendVersions(existing, versions []v1alpha1.ComponentVersion) []v1alpha1.ComponentVersion {
+	for _, version := range versions {
+		found := false
+		for _, existingVersion := range existing {
+			if existingVersion.Name == version.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			existing = append(existing, version)
+		}
+	}
+	return existing
+}
+
+// runGit runs a git command, returning its output in the error if it fails.
+func runGit(ctx context.Context, args ...string) error {
+	output, err := exec.CommandContext(ctx, "git", args...).CombinedOutput() // #nosec G204 -- arguments are passed to git, not to a shell
+	if err != nil {
+		return fmt.Errorf("git %s failed: %w: %s", args[0], err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// detectSubdirectories searches the subdirectories of dir for context directories, up to maxDepth.
+// Directories in which a component is detected are not searched any further. Directories whose devfile or
+// Dockerfile is one of the used paths are skipped.
+func detectSubdirectories(fsys fs.FS, dir string, depth, maxDepth int, used map[string]bool) ([]*detectedContext, error) {
+	if depth > maxDepth {
+		return nil, nil
+	}
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var contexts []*detectedContext
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || skippedDirs[entry.Name()] {
+			continue
+		}
+		subdir := path.Join(dir, entry.Name())
+		found, err := detectContext(fsys, subdir)
+		if err != nil {
+			return nil, err
+		}
+		if found != nil && (used[path.Join(subdir, found.devfile)] || used[path.Join(subdir, found.dockerfile)]) {
+			continue
+		}
+		if found != nil {
+			contexts = append(contexts, found)
+			continue
+		}
+		nested, err := detectSubdirectories(fsys, subdir, depth+1, maxDepth, used)
+		if err != nil {
+			return nil, err
+		}
+		contexts = append(contexts, nested...)
+	}
+	return contexts, nil
+}
+
+// detectContext checks whether dir is the context directory of a component, returning nil if it is not.
+func detectContext(fsys fs.FS, dir string) (*detectedContext, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	found := &detectedContext{
+		dir:        dir,
+		devfile:    findFirst(fsys, dir, devfileNames),
+		dockerfile: findFirst(fsys, dir, dockerfileNames),
+	}
+	if marker := findProjectMarker(entries); marker != nil {
+		found.language = marker.language
+		found.projectType = marker.projectType
+	}
+	if found.devfile != "" {
+		metadata := readDevfileMetadata(fsys, path.Join(dir, found.devfile))
+		found.devfileName = metadata.Name
+		if metadata.Language != "" {
+			found.language = metadata.Language
+		}
+		if metadata.ProjectType != "" {
+			found.projectType = metadata.ProjectType
+		}
+	}
+	if found.language == "" && found.dockerfile != "" {
+		found.language = dockerfileLanguage
+		found.projectType = dockerfileProjectType
+	}
+
+	if !found.hasBuildDefinition() && found.language == "" {
+		return nil, nil
+	}
+	return found, nil
+}
+
+// description returns the ComponentDetectionDescription of the component detected in the context directory,
+// with a proposed version for options.Revision if it is set.
+func (c *detectedContext) description(name string, options Options) v1alpha1.ComponentDetectionDescription {
+	contextDir := ""
+	if c.dir != "." {
+		contextDir = c.dir
+	}
+	description := v1alpha1.ComponentDetectionDescription{
+		DevfileFound: c.devfile != "",
+		Language:     c.language,
+		ProjectType:  c.projectType,
+		ComponentStub: v1alpha1.ComponentSpec{
+			ComponentName: name,
+			Source: v1alpha1.ComponentSource{
+				ComponentSourceUnion: v1alpha1.ComponentSourceUnion{
+					GitSource: &v1alpha1.GitSource{
+						URL:           options.URL,
+						Revision:      options.Revision,
+						Context:       contextDir,
+						DevfileURL:    c.devfile,
+						DockerfileURL: c.dockerfile,
+					},
+				},
+			},
+		},
+	}
+	if options.Revision != "" {
+		description.ProposedVersions = []v1alpha1.ComponentVersion{{
+			Name:          options.Revision,
+			Revision:      options.Revision,
+			Context:       contextDir,
+			DockerfileURI: c.dockerfile,
+		}}
+	}
+	return description
+}
+
+// componentName returns a component name for the context directory which is not yet used in detected.
+// The name of the directory is preferred, then its whole path within the repository.
+func componentName(found *detectedContext, options Options, detected v1alpha1.ComponentDetectionMap) string {
+	var candidates []string
+	if found.dir == "." {
+		candidates = []string{options.Name, repositoryName(options.URL), found.devfileName}
+	} else {
+		candidates = []string{path.Base(found.dir), strings.ReplaceAll(found.dir, "/", "-")}
+	}
+	candidates = append(candidates, defaultComponentName)
+
+	var base string
+	for _, candidate := range candidates {
+		name := v1alpha1.SanitizeComponentName(candidate)
+		if name == "" {
+			continue
+		}
+		if base == "" {
+			base = name
+		}
+		if _, used := detected[name]; !used {
+			return name
+		}
+	}
+
+	for i := 2; ; i++ {
+		name := v1alpha1.AppendComponentNameSuffix(base, strconv.Itoa(i))
+		if _, used := detected[name]; !used {
+			return name
+		}
+	}
+}
+
+// repositoryName returns the name of the repository from its URL, or an empty string.
+func repositoryName(url string) string {
+	url = strings.TrimSuffix(strings.TrimSuffix(url, "/"), ".git")
+	if i := strings.LastIndexAny(url, "/:"); i >= 0 {
+		return url[i+1:]
+	}
+	return ""
+}
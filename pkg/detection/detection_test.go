@@ -0,0 +1,137 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detection_test
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/application-api/pkg/detection"
+)
+
+// detectedComponent is the part of a detected component checked by the tests
+type detectedComponent struct {
+	context    string
+	dockerfile string
+	language   string
+}
+
+func TestDetect(t *testing.T) {
+	file := &fstest.MapFile{Data: []byte("content")}
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+		want map[string]detectedComponent
+	}{
+		{
+			name: "root Dockerfile in a subdirectory",
+			fsys: fstest.MapFS{"go.mod": file, "docker/Dockerfile": file},
+			want: map[string]detectedComponent{
+				"repo": {dockerfile: "docker/Dockerfile", language: "Go"},
+			},
+		},
+		{
+			name: "root Dockerfile in a subdirectory with another component",
+			fsys: fstest.MapFS{"go.mod": file, "build/Dockerfile": file, "web/package.json": file},
+			want: map[string]detectedComponent{
+				"repo": {dockerfile: "build/Dockerfile", language: "Go"},
+				"web":  {context: "web", language: "JavaScript"},
+			},
+		},
+		{
+			name: "root Dockerfile and subdirectory components",
+			fsys: fstest.MapFS{"Dockerfile": file, "api/go.mod": file, "web/Dockerfile": file},
+			want: map[string]detectedComponent{
+				"repo": {dockerfile: "Dockerfile", language: "Dockerfile"},
+				"api":  {context: "api", language: "Go"},
+				"web":  {context: "web", dockerfile: "Dockerfile", language: "Dockerfile"},
+			},
+		},
+		{
+			name: "root project marker and subdirectory components",
+			fsys: fstest.MapFS{"package.json": file, "services/api/go.mod": file},
+			want: map[string]detectedComponent{
+				"api": {context: "services/api", language: "Go"},
+			},
+		},
+		{
+			name: "root project marker only",
+			fsys: fstest.MapFS{"pom.xml": file, "src/Main.java": file},
+			want: map[string]detectedComponent{
+				"repo": {language: "Java"},
+			},
+		},
+		{
+			name: "skipped directories",
+			fsys: fstest.MapFS{"node_modules/x/package.json": file, ".github/Dockerfile": file},
+			want: map[string]detectedComponent{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detected, err := detection.Detect(tt.fsys, detection.Options{Name: "repo"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := map[string]detectedComponent{}
+			for name, description := range detected {
+				gitSource := description.ComponentStub.Source.GitSource
+				got[name] = detectedComponent{context: gitSource.Context, dockerfile: gitSource.DockerfileURL, language: description.Language}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Detect() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeRevisions(t *testing.T) {
+	stub := func(context string) v1alpha1.ComponentDetectionDescription {
+		return v1alpha1.ComponentDetectionDescription{ComponentStub: v1alpha1.ComponentSpec{
+			Source: v1alpha1.ComponentSource{ComponentSourceUnion: v1alpha1.ComponentSourceUnion{
+				GitSource: &v1alpha1.GitSource{Context: context},
+			}},
+		}}
+	}
+	long := strings.Repeat("a", 63)
+	results := []v1alpha1.ComponentDetectionMap{
+		{"api": stub("api"), long: stub("one")},
+		{"api": stub("api"), long: stub("two"), "web": stub("web")},
+		{"api": stub("other")},
+	}
+
+	merged := detection.MergeRevisions(results)
+	var names []string
+	for name, description := range merged {
+		if len(name) > 63 {
+			t.Errorf("name %q is longer than 63 characters", name)
+		}
+		if description.ComponentStub.ComponentName != name {
+			t.Errorf("component %s has the name %q", name, description.ComponentStub.ComponentName)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	want := []string{strings.Repeat("a", 61) + "-2", long, "api", "api-2", "web"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("MergeRevisions() names = %v, want %v", names, want)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detection
+
+import (
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/konflux-ci/application-api/pkg/devfile"
+)
+
+// devfileNames are the locations of a devfile relative to a context directory, in order of precedence
+var devfileNames = []string{
+	"devfile.yaml",
+	".devfile.yaml",
+	"devfile.yml",
+	".devfile.yml",
+	".devfile/devfile.yaml",
+	".devfile/.devfile.yaml",
+}
+
+// dockerfileNames are the locations of a Dockerfile or Containerfile relative to a context directory, in order of precedence
+var dockerfileNames = []string{
+	"Dockerfile",
+	"Containerfile",
+	"docker/Dockerfile",
+	"docker/Containerfile",
+	".docker/Dockerfile",
+	".docker/Containerfile",
+	"build/Dockerfile",
+	"build/Containerfile",
+}
+
+// projectMarker is a file which identifies the language and project type of a context directory
+type projectMarker struct {
+	// fileName is the name of the marker file, or its extension when it starts with '*'
+	fileName    string
+	language    string
+	projectType string
+}
+
+// projectMarkers are checked in order, the first one found in a context directory wins
+var projectMarkers = []projectMarker{
+	{fileName: "package.json", language: "JavaScript", projectType: "Node.js"},
+	{fileName: "go.mod", language: "Go", projectType: "Go"},
+	{fileName: "pom.xml", language: "Java", projectType: "Maven"},
+	{fileName: "build.gradle", language: "Java", projectType: "Gradle"},
+	{fileName: "build.gradle.kts", language: "Java", projectType: "Gradle"},
+	{fileName: "requirements.txt", language: "Python", projectType: "Python"},
+	{fileName: "pyproject.toml", language: "Python", projectType: "Python"},
+	{fileName: "setup.py", language: "Python", projectType: "Python"},
+	{fileName: "Pipfile", language: "Python", projectType: "Python"},
+	{fileName: "Cargo.toml", language: "Rust", projectType: "Rust"},
+	{fileName: "Gemfile", language: "Ruby", projectType: "Ruby"},
+	{fileName: "composer.json", language: "PHP", projectType: "PHP"},
+	{fileName: "*.csproj", language: "C#", projectType: ".NET"},
+	{fileName: "*.fsproj", language: "F#", projectType: ".NET"},
+}
+
+const (
+	// dockerfileLanguage and dockerfileProjectType describe contexts with a Dockerfile but no other project marker
+	dockerfileLanguage    = "Dockerfile"
+	dockerfileProjectType = "Dockerfile"
+)
+
+// findFirst returns the first of the given paths, relative to dir, which is a regular file in fsys.
+func findFirst(fsys fs.FS, dir string, names []string) string {
+	for _, name := range names {
+		if info, err := fs.Stat(fsys, path.Join(dir, name)); err == nil && info.Mode().IsRegular() {
+			return name
+		}
+	}
+	return ""
+}
+
+// findProjectMarker returns the first project marker found among the entries of a context directory.
+func findProjectMarker(entries []fs.DirEntry) *projectMarker {
+	for i, marker := range projectMarkers {
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			if strings.HasPrefix(marker.fileName, "*") {
+				if strings.HasSuffix(entry.Name(), marker.fileName[1:]) {
+					return &projectMarkers[i]
+				}
+			} else if entry.Name() == marker.fileName {
+				return &projectMarkers[i]
+			}
+		}
+	}
+	return nil
+}
+
+// readDevfileMetadata reads the metadata of a devfile, ignoring devfiles which cannot be parsed.
+func readDevfileMetadata(fsys fs.FS, devfilePath string) devfile.Metadata {
+	content, err := fs.ReadFile(fsys, devfilePath)
+	if err != nil {
+		return devfile.Metadata{}
+	}
+	parsed, err := devfile.Parse(string(content))
+	if err != nil {
+		return devfile.Metadata{}
+	}
+	return parsed.Metadata
+}
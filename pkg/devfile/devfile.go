@@ -0,0 +1,205 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package devfile parses and generates the devfiles stored in the status of Applications and Components.
+// Only the subset of the devfile schema relied on by this API is modelled: metadata, projects, components,
+// endpoints, environment variables and image builds. Other fields are kept in the Unknown field of each object,
+// so that a parsed devfile can be changed and written back without losing them.
+package devfile
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// SchemaVersion is the devfile schema version of generated devfiles
+	SchemaVersion = "2.2.0"
+
+	// Attributes of an Application devfile describing its repositories
+	AppModelRepositoryURLAttribute     = "appModelRepository.url"
+	AppModelRepositoryBranchAttribute  = "appModelRepository.branch"
+	AppModelRepositoryContextAttribute = "appModelRepository.context"
+	GitOpsRepositoryURLAttribute       = "gitOpsRepository.url"
+	GitOpsRepositoryBranchAttribute    = "gitOpsRepository.branch"
+	GitOpsRepositoryContextAttribute   = "gitOpsRepository.context"
+
+	// originRemote is the name of the git remote of generated projects
+	originRemote = "origin"
+)
+
+// Parse parses the YAML representation of a devfile.
+func Parse(data string) (*Devfile, error) {
+	devfile := &Devfile{}
+	if err := yaml.Unmarshal([]byte(data), devfile); err != nil {
+		return nil, fmt.Errorf("unable to parse devfile: %w", err)
+	}
+	if devfile.SchemaVersion == "" {
+		return nil, errors.New("unable to parse devfile: schemaVersion is not set")
+	}
+	return devfile, nil
+}
+
+// ParseApplicationDevfile parses the devfile in the status of the Application.
+func ParseApplicationDevfile(application *v1alpha1.Application) (*Devfile, error) {
+	return Parse(application.Status.Devfile)
+}
+
+// ParseComponentDevfile parses the devfile in the status of the Component.
+func ParseComponentDevfile(component *v1alpha1.Component) (*Devfile, error) {
+	return Parse(component.Status.Devfile)
+}
+
+// Marshal returns the YAML representation of the devfile, as stored in the Application and Component status.
+// The fields of a parsed devfile which are not modelled are written back as they were parsed.
+func (d *Devfile) Marshal() (string, error) {
+	data, err := yaml.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("unable to generate devfile: %w", err)
+	}
+	return string(data), nil
+}
+
+// ContainerComponents returns the container components of the devfile.
+func (d *Devfile) ContainerComponents() []Component {
+	return d.filterComponents(func(component Component) bool { return component.Container != nil })
+}
+
+// ImageComponents returns the image build components of the devfile.
+func (d *Devfile) ImageComponents() []Component {
+	return d.filterComponents(func(component Component) bool { return component.Image != nil })
+}
+
+// KubernetesComponents returns the Kubernetes components of the devfile.
+func (d *Devfile) KubernetesComponents() []Component {
+	return d.filterComponents(func(component Component) bool { return component.Kubernetes != nil })
+}
+
+// Endpoints returns the endpoints of all the container and Kubernetes components of the devfile.
+func (d *Devfile) Endpoints() []Endpoint {
+	var endpoints []Endpoint
+	for _, component := range d.Components {
+		if component.Container != nil {
+			endpoints = append(endpoints, component.Container.Endpoints...)
+		}
+		if component.Kubernetes != nil {
+			endpoints = append(endpoints, component.Kubernetes.Endpoints...)
+		}
+	}
+	return endpoints
+}
+
+func (d *Devfile) filterComponents(keep func(Component) bool) []Component {
+	var components []Component
+	for _, component := range d.Components {
+		if keep(component) {
+			components = append(components, component)
+		}
+	}
+	return components
+}
+
+// ApplicationDevfile builds the devfile of an Application from its Components.
+// The devfile has a project for each Component of the Application with a git repository, and records the
+// Application's app model and GitOps repositories in its metadata attributes. Components of other Applications are ignored.
+func ApplicationDevfile(application *v1alpha1.Application, components []v1alpha1.Component) *Devfile {
+	name := application.Spec.DisplayName
+	if name == "" {
+		name = application.Name
+	}
+
+	attributes := Attributes{}
+	setRepositoryAttributes(attributes, application.Spec.AppModelRepository,
+		AppModelRepositoryURLAttribute, AppModelRepositoryBranchAttribute, AppModelRepositoryContextAttribute)
+	setRepositoryAttributes(attributes, application.Spec.GitOpsRepository,
+		GitOpsRepositoryURLAttribute, GitOpsRepositoryBranchAttribute, GitOpsRepositoryContextAttribute)
+	if len(attributes) == 0 {
+		attributes = nil
+	}
+
+	devfile := &Devfile{
+		SchemaVersion: SchemaVersion,
+		Metadata: Metadata{
+			Name:        name,
+			Description: application.Spec.Description,
+			Attributes:  attributes,
+		},
+	}
+
+	for i := range components {
+		if components[i].Spec.Application != application.Name {
+			continue
+		}
+		if project := componentProject(&components[i]); project != nil {
+			devfile.Projects = append(devfile.Projects, *project)
+		}
+	}
+	sort.Slice(devfile.Projects, func(i, j int) bool {
+		return devfile.Projects[i].Name < devfile.Projects[j].Name
+	})
+	return devfile
+}
+
+// setRepositoryAttributes records the URL, branch and context of a repository under the given attribute keys.
+func setRepositoryAttributes(attributes Attributes, repository v1alpha1.ApplicationGitRepository, urlKey, branchKey, contextKey string) {
+	if repository.URL == "" {
+		return
+	}
+	attributes[urlKey] = repository.URL
+	if repository.Branch != "" {
+		attributes[branchKey] = repository.Branch
+	}
+	if repository.Context != "" {
+		attributes[contextKey] = repository.Context
+	}
+}
+
+// componentProject returns the devfile project of a Component, or nil if the Component has no git repository.
+func componentProject(component *v1alpha1.Component) *Project {
+	url := component.Spec.Source.GitURL
+	revision := ""
+	if gitSource := component.Spec.Source.GitSource; gitSource != nil {
+		if url == "" {
+			url = gitSource.URL
+		}
+		revision = gitSource.Revision
+	}
+	if url == "" {
+		return nil
+	}
+	if revision == "" && len(component.Spec.Source.Versions) > 0 {
+		revision = component.Spec.Source.Versions[0].Revision
+	}
+
+	name := component.Spec.ComponentName
+	if name == "" {
+		name = component.Name
+	}
+	project := &Project{
+		Name: name,
+		Git: &GitProjectSource{
+			Remotes: map[string]string{originRemote: url},
+		},
+	}
+	if revision != "" {
+		project.Git.CheckoutFrom = &CheckoutFrom{Revision: revision, Remote: originRemote}
+	}
+	return project
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package devfile
+
+// Attributes are free-form devfile attributes
+type Attributes map[string]interface{}
+
+// Devfile is the subset of a devfile (https://devfile.io) used by the Application and Component status
+type Devfile struct {
+	// SchemaVersion is the devfile schema version.
+	// Example: 2.2.0.
+	SchemaVersion string `json:"schemaVersion"`
+
+	// Metadata describes the devfile.
+	Metadata Metadata `json:"metadata,omitempty"`
+
+	// Projects are the git repositories of the devfile. An Application devfile has a project per Component.
+	Projects []Project `json:"projects,omitempty"`
+
+	// Components are the components of the devfile.
+	Components []Component `json:"components,omitempty"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
+
+// Metadata describes a devfile
+type Metadata struct {
+	// Name of the devfile.
+	Name string `json:"name,omitempty"`
+
+	// DisplayName of the devfile.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Description of the devfile.
+	Description string `json:"description,omitempty"`
+
+	// Language of the project.
+	// Example: JavaScript.
+	Language string `json:"language,omitempty"`
+
+	// ProjectType of the project.
+	// Example: Node.js.
+	ProjectType string `json:"projectType,omitempty"`
+
+	// Version of the devfile.
+	Version string `json:"version,omitempty"`
+
+	// Attributes are free-form metadata attributes.
+	// An Application devfile stores its GitOps and app model repositories here.
+	Attributes Attributes `json:"attributes,omitempty"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
+
+// Project is a git repository of a devfile
+type Project struct {
+	// Name of the project.
+	Name string `json:"name"`
+
+	// ClonePath is the path, relative to the root of the projects, the project is cloned into.
+	ClonePath string `json:"clonePath,omitempty"`
+
+	// Git describes the git repository of the project.
+	Git *GitProjectSource `json:"git,omitempty"`
+
+	// Attributes are free-form project attributes.
+	Attributes Attributes `json:"attributes,omitempty"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
+
+// GitProjectSource describes the git repository of a project
+type GitProjectSource struct {
+	// Remotes maps remote names to repository URLs.
+	Remotes map[string]string `json:"remotes"`
+
+	// CheckoutFrom defines what to check out from the remotes.
+	CheckoutFrom *CheckoutFrom `json:"checkoutFrom,omitempty"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
+
+// CheckoutFrom defines what to check out from the remotes of a project
+type CheckoutFrom struct {
+	// Revision is the branch, tag or commit id to check out.
+	Revision string `json:"revision,omitempty"`
+
+	// Remote is the name of the remote to check out from.
+	Remote string `json:"remote,omitempty"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
+
+// Component is a component of a devfile. At most one of Container, Image or Kubernetes is set, other kinds of
+// components, such as volumes, are kept in Unknown.
+type Component struct {
+	// Name of the component.
+	Name string `json:"name"`
+
+	// Attributes are free-form component attributes.
+	Attributes Attributes `json:"attributes,omitempty"`
+
+	// Container describes a container component.
+	Container *Container `json:"container,omitempty"`
+
+	// Image describes an image build component.
+	Image *Image `json:"image,omitempty"`
+
+	// Kubernetes describes a component deployed from Kubernetes manifests.
+	Kubernetes *Kubernetes `json:"kubernetes,omitempty"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
+
+// Container is a container component of a devfile
+type Container struct {
+	// Image is the container image.
+	Image string `json:"image"`
+
+	// Command overrides the entrypoint of the image.
+	Command []string `json:"command,omitempty"`
+
+	// Args are the arguments of the command.
+	Args []string `json:"args,omitempty"`
+
+	// Env is the environment of the container.
+	Env []EnvVar `json:"env,omitempty"`
+
+	// Endpoints are the endpoints exposed by the container.
+	Endpoints []Endpoint `json:"endpoints,omitempty"`
+
+	// MemoryLimit of the container.
+	// Example: 1Gi.
+	MemoryLimit string `json:"memoryLimit,omitempty"`
+
+	// MemoryRequest of the container.
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+
+	// CPULimit of the container.
+	// Example: 500m.
+	CPULimit string `json:"cpuLimit,omitempty"`
+
+	// CPURequest of the container.
+	CPURequest string `json:"cpuRequest,omitempty"`
+
+	// MountSources tells whether the project sources are mounted into the container.
+	MountSources *bool `json:"mountSources,omitempty"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
+
+// EnvVar is an environment variable of a container
+type EnvVar struct {
+	// Name of the variable.
+	Name string `json:"name"`
+
+	// Value of the variable.
+	Value string `json:"value"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
+
+// Endpoint is an endpoint exposed by a component
+type Endpoint struct {
+	// Name of the endpoint.
+	Name string `json:"name"`
+
+	// TargetPort is the port the endpoint listens on.
+	TargetPort int `json:"targetPort"`
+
+	// Exposure of the endpoint: public, internal or none.
+	Exposure string `json:"exposure,omitempty"`
+
+	// Protocol of the endpoint.
+	// Example: http.
+	Protocol string `json:"protocol,omitempty"`
+
+	// Path of the endpoint URL.
+	Path string `json:"path,omitempty"`
+
+	// Secure tells whether the endpoint is secured.
+	Secure *bool `json:"secure,omitempty"`
+
+	// Attributes are free-form endpoint attributes.
+	Attributes Attributes `json:"attributes,omitempty"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
+
+// Image is an image build component of a devfile
+type Image struct {
+	// ImageName is the name of the image to build.
+	ImageName string `json:"imageName"`
+
+	// AutoBuild tells whether the image is built at startup.
+	AutoBuild *bool `json:"autoBuild,omitempty"`
+
+	// Dockerfile describes how to build the image from a Dockerfile.
+	Dockerfile *DockerfileImage `json:"dockerfile,omitempty"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
+
+// DockerfileImage describes how to build an image from a Dockerfile
+type DockerfileImage struct {
+	// URI of the Dockerfile, either relative to the devfile or an external URL.
+	URI string `json:"uri,omitempty"`
+
+	// BuildContext is the path of the build context, relative to the project.
+	BuildContext string `json:"buildContext,omitempty"`
+
+	// Args are the build arguments.
+	Args []string `json:"args,omitempty"`
+
+	// RootRequired tells whether the build requires root privileges.
+	RootRequired *bool `json:"rootRequired,omitempty"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
+
+// Kubernetes is a component of a devfile deployed from Kubernetes manifests
+type Kubernetes struct {
+	// URI of the manifests.
+	URI string `json:"uri,omitempty"`
+
+	// Inlined manifests.
+	Inlined string `json:"inlined,omitempty"`
+
+	// Endpoints are the endpoints exposed by the manifests.
+	Endpoints []Endpoint `json:"endpoints,omitempty"`
+
+	// DeployByDefault tells whether the manifests are deployed at startup.
+	DeployByDefault *bool `json:"deployByDefault,omitempty"`
+
+	// Unknown holds the fields which are not modelled.
+	Unknown Unknown `json:"-"`
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package devfile
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Unknown holds the fields of a devfile object which are not modelled, so that a parsed devfile is written back
+// by Marshal without losing them
+type Unknown map[string]json.RawMessage
+
+// unmarshalKnown unmarshals the fields of data modelled by the struct pointed to by v, and keeps the others in unknown.
+func unmarshalKnown(data []byte, v interface{}, unknown *Unknown) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	known := jsonFieldNames(reflect.TypeOf(v).Elem())
+	for name := range fields {
+		for _, knownName := range known {
+			// Fields are matched case-insensitively by encoding/json
+			if strings.EqualFold(name, knownName) {
+				delete(fields, name)
+				break
+			}
+		}
+	}
+	*unknown = nil
+	if len(fields) > 0 {
+		*unknown = fields
+	}
+	return nil
+}
+
+// marshalKnown marshals the struct v, adding the unknown fields which it does not set.
+func marshalKnown(v interface{}, unknown Unknown) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(unknown) == 0 {
+		return data, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range unknown {
+		if _, set := fields[name]; !set {
+			fields[name] = value
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// jsonFieldNames returns the JSON names of the fields of a struct type.
+func jsonFieldNames(t reflect.Type) []string {
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = t.Field(i).Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// The devfile objects keep the fields they do not model in Unknown when unmarshalled, and write them back when marshalled.
+
+func (d *Devfile) UnmarshalJSON(data []byte) error {
+	type plain Devfile
+	return unmarshalKnown(data, (*plain)(d), &d.Unknown)
+}
+
+func (d Devfile) MarshalJSON() ([]byte, error) {
+	type plain Devfile
+	return marshalKnown(plain(d), d.Unknown)
+}
+
+func (m *Metadata) UnmarshalJSON(data []byte) error {
+	type plain Metadata
+	return unmarshalKnown(data, (*plain)(m), &m.Unknown)
+}
+
+func (m Metadata) MarshalJSON() ([]byte, error) {
+	type plain Metadata
+	return marshalKnown(plain(m), m.Unknown)
+}
+
+func (p *Project) UnmarshalJSON(data []byte) error {
+	type plain Project
+	return unmarshalKnown(data, (*plain)(p), &p.Unknown)
+}
+
+func (p Project) MarshalJSON() ([]byte, error) {
+	type plain Project
+	return marshalKnown(plain(p), p.Unknown)
+}
+
+func (g *GitProjectSource) UnmarshalJSON(data []byte) error {
+	type plain GitProjectSource
+	return unmarshalKnown(data, (*plain)(g), &g.Unknown)
+}
+
+func (g GitProjectSource) MarshalJSON() ([]byte, error) {
+	type plain GitProjectSource
+	return marshalKnown(plain(g), g.Unknown)
+}
+
+func (c *CheckoutFrom) UnmarshalJSON(data []byte) error {
+	type plain CheckoutFrom
+	return unmarshalKnown(data, (*plain)(c), &c.Unknown)
+}
+
+func (c CheckoutFrom) MarshalJSON() ([]byte, error) {
+	type plain CheckoutFrom
+	return marshalKnown(plain(c), c.Unknown)
+}
+
+func (c *Component) UnmarshalJSON(data []byte) error {
+	type plain Component
+	return unmarshalKnown(data, (*plain)(c), &c.Unknown)
+}
+
+func (c Component) MarshalJSON() ([]byte, error) {
+	type plain Component
+	return marshalKnown(plain(c), c.Unknown)
+}
+
+func (c *Container) UnmarshalJSON(data []byte) error {
+	type plain Container
+	return unmarshalKnown(data, (*plain)(c), &c.Unknown)
+}
+
+func (c Container) MarshalJSON() ([]byte, error) {
+	type plain Container
+	return marshalKnown(plain(c), c.Unknown)
+}
+
+func (e *EnvVar) UnmarshalJSON(data []byte) error {
+	type plain EnvVar
+	return unmarshalKnown(data, (*plain)(e), &e.Unknown)
+}
+
+func (e EnvVar) MarshalJSON() ([]byte, error) {
+	type plain EnvVar
+	return marshalKnown(plain(e), e.Unknown)
+}
+
+func (e *Endpoint) UnmarshalJSON(data []byte) error {
+	type plain Endpoint
+	return unmarshalKnown(data, (*plain)(e), &e.Unknown)
+}
+
+func (e Endpoint) MarshalJSON() ([]byte, error) {
+	type plain Endpoint
+	return marshalKnown(plain(e), e.Unknown)
+}
+
+func (i *Image) UnmarshalJSON(data []byte) error {
+	type plain Image
+	return unmarshalKnown(data, (*plain)(i), &i.Unknown)
+}
+
+func (i Image) MarshalJSON() ([]byte, error) {
+	type plain Image
+	return marshalKnown(plain(i), i.Unknown)
+}
+
+func (d *DockerfileImage) UnmarshalJSON(data []byte) error {
+	type plain DockerfileImage
+	return unmarshalKnown(data, (*plain)(d), &d.Unknown)
+}
+
+func (d DockerfileImage) MarshalJSON() ([]byte, error) {
+	type plain DockerfileImage
+	return marshalKnown(plain(d), d.Unknown)
+}
+
+func (k *Kubernetes) UnmarshalJSON(data []byte) error {
+	type plain Kubernetes
+	return unmarshalKnown(data, (*plain)(k), &k.Unknown)
+}
+
+func (k Kubernetes) MarshalJSON() ([]byte, error) {
+	type plain Kubernetes
+	return marshalKnown(plain(k), k.Unknown)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gitutil parses, normalizes and validates the git repository URLs used by the source fields of the API.
+package gitutil
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Vendor is the vendor of a git hosting service
+type Vendor string
+
+const (
+	GitHub     Vendor = "github"
+	GitLab     Vendor = "gitlab"
+	Bitbucket  Vendor = "bitbucket"
+	Forgejo    Vendor = "forgejo"
+	SelfHosted Vendor = "self-hosted"
+)
+
+// DefaultAllowedVendors are the vendors accepted by validation when no allow-list is given
+var DefaultAllowedVendors = []Vendor{GitHub, GitLab, Bitbucket, Forgejo}
+
+// scpLikeURL matches scp-style URLs such as git@github.com:owner/repo.git
+var scpLikeURL = regexp.MustCompile(`^(?:[^@/:]+@)?([^@/:]+):([^/].*)$`)
+
+// URL is a parsed git repository URL
+type URL struct {
+	// Scheme is the scheme of the normalized URL, either https or http
+	Scheme string
+
+	// Host is the lower case host name of the git server, with the port for http(s) URLs which set one
+	Host string
+
+	// Path is the path of the repository on the git server, without leading or trailing slashes or .git suffix.
+	// It is in lower case on GitHub, GitLab and Bitbucket, whose paths are case-insensitive; the case of the paths
+	// of other servers is kept, since whether they are case-sensitive depends on their configuration.
+	// Example: owner/repo, or group/subgroup/repo on GitLab.
+	Path string
+
+	// Vendor is the vendor of the git server
+	Vendor Vendor
+}
+
+// Owner returns the owner of the repository: the user, organization or (sub)group.
+func (u *URL) Owner() string {
+	if i := strings.LastIndex(u.Path, "/"); i >= 0 {
+		return u.Path[:i]
+	}
+	return ""
+}
+
+// Repository returns the name of the repository.
+func (u *URL) Repository() string {
+	return u.Path[strings.LastIndex(u.Path, "/")+1:]
+}
+
+// String returns the normalized URL, for example https://github.com/owner/repo.
+func (u *URL) String() string {
+	return u.Scheme + "://" + u.Host + "/" + u.Path
+}
+
+// Parse parses a git repository URL in any of these forms:
+//   - https://github.com/owner/repo, optionally with a .git suffix or a trailing slash
+//   - http://git.example.com/owner/repo
+//   - ssh://git@github.com/owner/repo.git, optionally with a port
+//   - git://github.com/owner/repo.git
+//   - git@github.com:owner/repo.git (scp-style)
+func Parse(rawURL string) (*URL, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return nil, errors.New("git URL is empty")
+	}
+
+	var scheme, host, path string
+	if strings.Contains(rawURL, "://") {
+		parsed, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid git URL %s: %w", rawURL, err)
+		}
+		switch strings.ToLower(parsed.Scheme) {
+		case "https", "http":
+			scheme = strings.ToLower(parsed.Scheme)
+			host = strings.ToLower(parsed.Host)
+		case "ssh", "git", "git+ssh", "ssh+git":
+			scheme = "https"
+			host = strings.ToLower(parsed.Hostname())
+		default:
+			return nil, fmt.Errorf("invalid git URL %s: unsupported scheme %s", rawURL, parsed.Scheme)
+		}
+		if parsed.RawQuery != "" || parsed.Fragment != "" {
+			return nil, fmt.Errorf("invalid git URL %s: query and fragment are not supported", rawURL)
+		}
+		path = parsed.Path
+	} else if match := scpLikeURL.FindStringSubmatch(rawURL); match != nil {
+		scheme = "https"
+		host = strings.ToLower(match[1])
+		path = match[2]
+	} else {
+		return nil, fmt.Errorf("invalid git URL %s: not an absolute URL", rawURL)
+	}
+
+	path = strings.Trim(path, "/")
+	path = strings.TrimSuffix(path, ".git")
+	path = strings.TrimRight(path, "/")
+	if host == "" {
+		return nil, fmt.Errorf("invalid git URL %s: no host", rawURL)
+	}
+	if !strings.Contains(path, "/") {
+		return nil, fmt.Errorf("invalid git URL %s: the path must contain an owner and a repository", rawURL)
+	}
+
+	vendor := DetectVendor(host)
+	switch vendor {
+	case GitHub, GitLab, Bitbucket:
+		path = strings.ToLower(path)
+	}
+	return &URL{
+		Scheme: scheme,
+		Host:   host,
+		Path:   path,
+		Vendor: vendor,
+	}, nil
+}
+
+// Normalize returns the normalized form of a git repository URL, as returned by URL.String.
+// URLs of the same repository in any of the forms accepted by Parse have the same normalized form, whatever
+// the case of their path on the public services with case-insensitive paths, see URL.Path.
+func Normalize(rawURL string) (string, error) {
+	parsed, err := Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	return parsed.String(), nil
+}
+
+// DetectVendor returns the vendor of a git server from its host name. Only the public services are recognized:
+// github.com, gitlab.com, bitbucket.org, and codeberg.org and gitea.com for Forgejo. Any other host, including
+// self-managed instances and GitHub Enterprise servers, is SelfHosted; their vendor can be given to validation
+// with ValidationOptions.Hosts.
+func DetectVendor(host string) Vendor {
+	switch hostName(host) {
+	case "github.com":
+		return GitHub
+	case "gitlab.com":
+		return GitLab
+	case "bitbucket.org":
+		return Bitbucket
+	case "codeberg.org", "gitea.com":
+		return Forgejo
+	default:
+		return SelfHosted
+	}
+}
+
+// hostName returns the lower case name of a host, without its port.
+func hostName(host string) string {
+	host = strings.ToLower(host)
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		host = host[:i]
+	}
+	return host
+}
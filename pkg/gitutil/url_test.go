@@ -0,0 +1,137 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitutil_test
+
+import (
+	"testing"
+
+	"github.com/konflux-ci/application-api/pkg/gitutil"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		rawURL string
+		want   gitutil.URL
+	}{
+		{rawURL: "https://github.com/owner/repo", want: gitutil.URL{Scheme: "https", Host: "github.com", Path: "owner/repo", Vendor: gitutil.GitHub}},
+		{rawURL: "https://github.com/owner/repo.git", want: gitutil.URL{Scheme: "https", Host: "github.com", Path: "owner/repo", Vendor: gitutil.GitHub}},
+		{rawURL: "https://github.com/owner/repo/", want: gitutil.URL{Scheme: "https", Host: "github.com", Path: "owner/repo", Vendor: gitutil.GitHub}},
+		{rawURL: "https://github.com/owner/repo.git/", want: gitutil.URL{Scheme: "https", Host: "github.com", Path: "owner/repo", Vendor: gitutil.GitHub}},
+		{rawURL: " HTTPS://GitHub.com/Owner/Repo ", want: gitutil.URL{Scheme: "https", Host: "github.com", Path: "owner/repo", Vendor: gitutil.GitHub}},
+		{rawURL: "http://git.example.com/Owner/Repo", want: gitutil.URL{Scheme: "http", Host: "git.example.com", Path: "Owner/Repo", Vendor: gitutil.SelfHosted}},
+		{rawURL: "https://git.example.com:8443/owner/repo", want: gitutil.URL{Scheme: "https", Host: "git.example.com:8443", Path: "owner/repo", Vendor: gitutil.SelfHosted}},
+		{rawURL: "https://gitlab.com/Group/SubGroup/Repo.git", want: gitutil.URL{Scheme: "https", Host: "gitlab.com", Path: "group/subgroup/repo", Vendor: gitutil.GitLab}},
+		{rawURL: "https://bitbucket.org/Owner/Repo", want: gitutil.URL{Scheme: "https", Host: "bitbucket.org", Path: "owner/repo", Vendor: gitutil.Bitbucket}},
+		{rawURL: "https://codeberg.org/Owner/Repo", want: gitutil.URL{Scheme: "https", Host: "codeberg.org", Path: "Owner/Repo", Vendor: gitutil.Forgejo}},
+		{rawURL: "ssh://git@github.com/owner/repo.git", want: gitutil.URL{Scheme: "https", Host: "github.com", Path: "owner/repo", Vendor: gitutil.GitHub}},
+		{rawURL: "ssh://git@gitlab.com:2222/group/repo.git", want: gitutil.URL{Scheme: "https", Host: "gitlab.com", Path: "group/repo", Vendor: gitutil.GitLab}},
+		{rawURL: "git://github.com/owner/repo.git", want: gitutil.URL{Scheme: "https", Host: "github.com", Path: "owner/repo", Vendor: gitutil.GitHub}},
+		{rawURL: "git+ssh://git@github.com/owner/repo", want: gitutil.URL{Scheme: "https", Host: "github.com", Path: "owner/repo", Vendor: gitutil.GitHub}},
+		{rawURL: "git@github.com:owner/repo.git", want: gitutil.URL{Scheme: "https", Host: "github.com", Path: "owner/repo", Vendor: gitutil.GitHub}},
+		{rawURL: "github.com:owner/repo", want: gitutil.URL{Scheme: "https", Host: "github.com", Path: "owner/repo", Vendor: gitutil.GitHub}},
+		{rawURL: "git@gitlab.example.com:group/sub/repo.git", want: gitutil.URL{Scheme: "https", Host: "gitlab.example.com", Path: "group/sub/repo", Vendor: gitutil.SelfHosted}},
+		{rawURL: "https://github.example.com/owner/repo", want: gitutil.URL{Scheme: "https", Host: "github.example.com", Path: "owner/repo", Vendor: gitutil.SelfHosted}},
+		{rawURL: "https://mygithub.com/owner/repo", want: gitutil.URL{Scheme: "https", Host: "mygithub.com", Path: "owner/repo", Vendor: gitutil.SelfHosted}},
+	}
+	for _, tt := range tests {
+		got, err := gitutil.Parse(tt.rawURL)
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", tt.rawURL, err)
+			continue
+		}
+		if *got != tt.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.rawURL, *got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, rawURL := range []string{
+		"",
+		"   ",
+		"github.com/owner/repo",
+		"/owner/repo",
+		"https://github.com/repo",
+		"https://github.com/",
+		"ftp://github.com/owner/repo",
+		"https://github.com/owner/repo?ref=main",
+		"https://github.com/owner/repo#main",
+		"https:///owner/repo",
+		"git@github.com:/owner/repo",
+	} {
+		if got, err := gitutil.Parse(rawURL); err == nil {
+			t.Errorf("Parse(%q) = %+v, want an error", rawURL, *got)
+		}
+	}
+}
+
+func TestURLParts(t *testing.T) {
+	u, err := gitutil.Parse("https://gitlab.com/group/subgroup/repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Owner() != "group/subgroup" || u.Repository() != "repo" || u.String() != "https://gitlab.com/group/subgroup/repo" {
+		t.Errorf("Owner() = %q, Repository() = %q, String() = %q", u.Owner(), u.Repository(), u.String())
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		rawURL string
+		want   string
+	}{
+		{rawURL: "https://github.com/org/repo", want: "https://github.com/org/repo"},
+		{rawURL: "https://github.com/Org/Repo", want: "https://github.com/org/repo"},
+		{rawURL: "https://github.com/org/repo.git", want: "https://github.com/org/repo"},
+		{rawURL: "https://github.com/org/repo/", want: "https://github.com/org/repo"},
+		{rawURL: "ssh://git@github.com/org/repo.git", want: "https://github.com/org/repo"},
+		{rawURL: "ssh://git@github.com:22/org/repo.git", want: "https://github.com/org/repo"},
+		{rawURL: "git@github.com:Org/Repo.git", want: "https://github.com/org/repo"},
+		{rawURL: "http://github.com/org/repo", want: "http://github.com/org/repo"},
+		{rawURL: "https://git.example.com:8443/Org/Repo.git", want: "https://git.example.com:8443/Org/Repo"},
+		{rawURL: "https://GIT.example.com:8443/Org/Repo/", want: "https://git.example.com:8443/Org/Repo"},
+	}
+	for _, tt := range tests {
+		got, err := gitutil.Normalize(tt.rawURL)
+		if err != nil {
+			t.Errorf("Normalize(%q) returned error: %v", tt.rawURL, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.rawURL, got, tt.want)
+		}
+	}
+}
+
+func TestDetectVendor(t *testing.T) {
+	tests := map[string]gitutil.Vendor{
+		"github.com":         gitutil.GitHub,
+		"GitHub.com:443":     gitutil.GitHub,
+		"gitlab.com":         gitutil.GitLab,
+		"bitbucket.org":      gitutil.Bitbucket,
+		"codeberg.org":       gitutil.Forgejo,
+		"gitea.com":          gitutil.Forgejo,
+		"github.example.com": gitutil.SelfHosted,
+		"gitlab.example.com": gitutil.SelfHosted,
+		"www.github.com":     gitutil.SelfHosted,
+	}
+	for host, want := range tests {
+		if got := gitutil.DetectVendor(host); got != want {
+			t.Errorf("DetectVendor(%q) = %q, want %q", host, got, want)
+		}
+	}
+}
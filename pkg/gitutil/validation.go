@@ -0,0 +1,139 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidationOptions configures the validation of git repository URLs
+type ValidationOptions struct {
+	// AllowedVendors are the vendors whose URLs are accepted, DefaultAllowedVendors when empty
+	AllowedVendors []Vendor
+
+	// Hosts maps the host names of self-managed git servers to their vendor, such as gitlab.example.com to GitLab,
+	// or github.example.com to GitHub for a GitHub Enterprise server. Host names are case-insensitive, and match
+	// URLs with any port. Hosts which are neither public services nor listed are SelfHosted, see DetectVendor.
+	Hosts map[string]Vendor
+}
+
+// Vendor returns the vendor of a parsed URL: the vendor of its host in Hosts, or else the detected vendor.
+func (o ValidationOptions) Vendor(u *URL) Vendor {
+	name := hostName(u.Host)
+	for host, vendor := range o.Hosts {
+		if hostName(host) == name {
+			return vendor
+		}
+	}
+	return u.Vendor
+}
+
+// ValidateURL checks that rawURL is a git repository URL accepted by Parse, hosted by one of the allowed vendors.
+func ValidateURL(rawURL string, options ValidationOptions) error {
+	parsed, err := Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	allowed := options.AllowedVendors
+	if len(allowed) == 0 {
+		allowed = DefaultAllowedVendors
+	}
+	vendor := options.Vendor(parsed)
+	for _, allowedVendor := range allowed {
+		if vendor == allowedVendor {
+			return nil
+		}
+	}
+	vendors := make([]string, 0, len(allowed))
+	for _, allowedVendor := range allowed {
+		vendors = append(vendors, string(allowedVendor))
+	}
+	return fmt.Errorf(v1alpha1.InvalidGithubVendorURL, rawURL, strings.Join(vendors, ", "))
+}
+
+// ValidateComponentURLs validates the git repository URLs of a Component with ValidateURL: the source URL,
+// the URL of the old model git source and the URLs of the pipelines resolved from git. Empty URLs are skipped.
+func ValidateComponentURLs(component *v1alpha1.Component, options ValidationOptions) error {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+	sourcePath := specPath.Child("source")
+
+	errs = append(errs, validateURLField(sourcePath.Child("url"), component.Spec.Source.GitURL, options)...)
+	if gitSource := component.Spec.Source.GitSource; gitSource != nil {
+		errs = append(errs, validateURLField(sourcePath.Child("git", "url"), gitSource.URL, options)...)
+	}
+	errs = append(errs, validateBuildPipelineURLs(specPath.Child("default-build-pipeline"), component.Spec.DefaultBuildPipeline, options)...)
+	for i, version := range component.Spec.Source.Versions {
+		errs = append(errs, validateBuildPipelineURLs(sourcePath.Child("versions").Index(i).Child("build-pipeline"), version.BuildPipeline, options)...)
+	}
+	return errs.ToAggregate()
+}
+
+// ValidateApplicationURLs validates the app model and GitOps repository URLs of an Application with ValidateURL.
+// Empty URLs are skipped, as the repositories are generated when they are not set.
+func ValidateApplicationURLs(application *v1alpha1.Application, options ValidationOptions) error {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+	errs = append(errs, validateURLField(specPath.Child("appModelRepository", "url"), application.Spec.AppModelRepository.URL, options)...)
+	errs = append(errs, validateURLField(specPath.Child("gitOpsRepository", "url"), application.Spec.GitOpsRepository.URL, options)...)
+	return errs.ToAggregate()
+}
+
+// ValidateComponentDetectionQueryURLs validates the git source URL of a ComponentDetectionQuery with ValidateURL.
+func ValidateComponentDetectionQueryURLs(cdq *v1alpha1.ComponentDetectionQuery, options ValidationOptions) error {
+	path := field.NewPath("spec", "git", "url")
+	if cdq.Spec.GitSource.URL == "" {
+		return field.ErrorList{field.Required(path, "")}.ToAggregate()
+	}
+	return validateURLField(path, cdq.Spec.GitSource.URL, options).ToAggregate()
+}
+
+func validateBuildPipelineURLs(path *field.Path, pipeline *v1alpha1.ComponentBuildPipeline, options ValidationOptions) field.ErrorList {
+	if pipeline == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	for _, definition := range []struct {
+		name       string
+		definition *v1alpha1.PipelineDefinition
+	}{
+		{"pull-and-push", pipeline.PullAndPush},
+		{"pull", pipeline.Pull},
+		{"push", pipeline.Push},
+	} {
+		if definition.definition == nil || definition.definition.PipelineRefGit == nil {
+			continue
+		}
+		urlPath := path.Child(definition.name, "pipelineref-by-git-resolver", "url")
+		errs = append(errs, validateURLField(urlPath, definition.definition.PipelineRefGit.Url, options)...)
+	}
+	return errs
+}
+
+func validateURLField(path *field.Path, rawURL string, options ValidationOptions) field.ErrorList {
+	if rawURL == "" {
+		return nil
+	}
+	if err := ValidateURL(rawURL, options); err != nil {
+		return field.ErrorList{field.Invalid(path, rawURL, err.Error())}
+	}
+	return nil
+}
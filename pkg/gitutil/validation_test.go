@@ -0,0 +1,126 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitutil_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/application-api/pkg/gitutil"
+)
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		rawURL  string
+		options gitutil.ValidationOptions
+		valid   bool
+	}{
+		{name: "default vendors", rawURL: "https://github.com/org/repo", valid: true},
+		{name: "self-hosted not allowed by default", rawURL: "https://git.example.com/org/repo", valid: false},
+		{name: "invalid URL", rawURL: "not a url", valid: false},
+		{
+			name:    "allowed vendor",
+			rawURL:  "git@gitlab.com:org/repo.git",
+			options: gitutil.ValidationOptions{AllowedVendors: []gitutil.Vendor{gitutil.GitLab}},
+			valid:   true,
+		},
+		{
+			name:    "disallowed vendor",
+			rawURL:  "https://github.com/org/repo",
+			options: gitutil.ValidationOptions{AllowedVendors: []gitutil.Vendor{gitutil.GitLab}},
+			valid:   false,
+		},
+		{
+			name:    "self-hosted allowed",
+			rawURL:  "https://git.example.com/org/repo",
+			options: gitutil.ValidationOptions{AllowedVendors: []gitutil.Vendor{gitutil.SelfHosted}},
+			valid:   true,
+		},
+		{
+			name:   "host mapped to an allowed vendor",
+			rawURL: "https://gitlab.example.com/org/repo",
+			options: gitutil.ValidationOptions{
+				AllowedVendors: []gitutil.Vendor{gitutil.GitLab},
+				Hosts:          map[string]gitutil.Vendor{"gitlab.example.com": gitutil.GitLab},
+			},
+			valid: true,
+		},
+		{
+			name:   "host mapped case-insensitively and with any port",
+			rawURL: "ssh://git@GitLab.Example.com:2222/org/repo.git",
+			options: gitutil.ValidationOptions{
+				AllowedVendors: []gitutil.Vendor{gitutil.GitLab},
+				Hosts:          map[string]gitutil.Vendor{"gitlab.example.COM:443": gitutil.GitLab},
+			},
+			valid: true,
+		},
+		{
+			name:   "host mapped to a disallowed vendor",
+			rawURL: "https://github.example.com/org/repo",
+			options: gitutil.ValidationOptions{
+				AllowedVendors: []gitutil.Vendor{gitutil.GitLab},
+				Hosts:          map[string]gitutil.Vendor{"github.example.com": gitutil.GitHub},
+			},
+			valid: false,
+		},
+		{
+			name:   "other hosts are not mapped",
+			rawURL: "https://gitlab.example.org/org/repo",
+			options: gitutil.ValidationOptions{
+				AllowedVendors: []gitutil.Vendor{gitutil.GitLab},
+				Hosts:          map[string]gitutil.Vendor{"gitlab.example.com": gitutil.GitLab},
+			},
+			valid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := gitutil.ValidateURL(tt.rawURL, tt.options); (err == nil) != tt.valid {
+				t.Errorf("ValidateURL(%q) = %v, want valid %v", tt.rawURL, err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestValidateComponentURLs(t *testing.T) {
+	component := &v1alpha1.Component{Spec: v1alpha1.ComponentSpec{
+		Source: v1alpha1.ComponentSource{ComponentSourceUnion: v1alpha1.ComponentSourceUnion{
+			GitURL:    "https://github.com/org/repo",
+			GitSource: &v1alpha1.GitSource{URL: "https://git.example.com/org/repo"},
+			Versions: []v1alpha1.ComponentVersion{{
+				Name: "main",
+				BuildPipeline: &v1alpha1.ComponentBuildPipeline{
+					Push: &v1alpha1.PipelineDefinition{PipelineRefGit: &v1alpha1.PipelineRefGit{Url: "https://bitbucket.org/org/pipelines"}},
+				},
+			}},
+		}},
+	}}
+	err := gitutil.ValidateComponentURLs(component, gitutil.ValidationOptions{AllowedVendors: []gitutil.Vendor{gitutil.GitHub}})
+	if err == nil {
+		t.Fatal("ValidateComponentURLs() returned no error")
+	}
+	for _, field := range []string{"spec.source.git.url", "spec.source.versions[0].build-pipeline.push.pipelineref-by-git-resolver.url"} {
+		if !strings.Contains(err.Error(), field) {
+			t.Errorf("ValidateComponentURLs() = %v, want an error for %s", err, field)
+		}
+	}
+	if strings.Contains(err.Error(), "spec.source.url") {
+		t.Errorf("ValidateComponentURLs() = %v, want no error for spec.source.url", err)
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kcp generates the KCP API of the CustomResourceDefinitions: an APIResourceSchema per resource, the
+// APIExport publishing them and the APIBinding consuming it.
+//
+// APIResourceSchemas are immutable, so a changed schema must get a new name. Names are prefixed with a hash of
+// the schema content, such as v0a1b2c3d4e.applications.appstudio.redhat.com: regenerating unchanged CRDs
+// yields identical files, while any change to a schema yields a new name.
+package kcp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ExportName is the name of the APIExport of the API
+	ExportName = "application-api"
+
+	// BindingName is the name of the APIBinding of the API
+	BindingName = "application-api-binding"
+
+	// Names of the generated files
+	SchemasFile = "apiresourceschema.yaml"
+	ExportFile  = "apiexport.yaml"
+	BindingFile = "apibinding.yaml"
+
+	// prefixLength is the number of hexadecimal digits of the schema hash in the name prefix
+	prefixLength = 10
+
+	header = "# This file is generated from CRDs by `make manifests-kcp`.\n# Please do not modify!\n\n"
+)
+
+// PermissionClaims are the resources that the API needs access to in the bound workspaces
+var PermissionClaims = []PermissionClaim{
+	{Group: "", Resource: "configmaps"},
+	{Group: "", Resource: "namespaces"},
+	{Group: "", Resource: "secrets"},
+}
+
+// NewAPIResourceSchema returns the APIResourceSchema of a CustomResourceDefinition, named after the hash of its spec.
+func NewAPIResourceSchema(crd *apiextensionsv1.CustomResourceDefinition) (*APIResourceSchema, error) {
+	spec := APIResourceSchemaSpec{
+		Group: crd.Spec.Group,
+		Names: crd.Spec.Names,
+		Scope: crd.Spec.Scope,
+	}
+	for _, version := range crd.Spec.Versions {
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			return nil, fmt.Errorf("version %s of %s has no schema", version.Name, crd.Name)
+		}
+		schema, err := json.Marshal(version.Schema.OpenAPIV3Schema)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode the schema of version %s of %s: %w", version.Name, crd.Name, err)
+		}
+		resourceVersion := APIResourceVersion{
+			Name:                     version.Name,
+			Served:                   version.Served,
+			Storage:                  version.Storage,
+			Deprecated:               version.Deprecated,
+			DeprecationWarning:       version.DeprecationWarning,
+			Schema:                   runtime.RawExtension{Raw: schema},
+			AdditionalPrinterColumns: version.AdditionalPrinterColumns,
+		}
+		if version.Subresources != nil {
+			resourceVersion.Subresources = *version.Subresources
+		}
+		spec.Versions = append(spec.Versions, resourceVersion)
+	}
+
+	prefix, err := SchemaPrefix(&spec)
+	if err != nil {
+		return nil, err
+	}
+	return &APIResourceSchema{
+		TypeMeta: metav1.TypeMeta{APIVersion: APIVersion, Kind: "APIResourceSchema"},
+		Metadata: ObjectMeta{Name: fmt.Sprintf("%s.%s.%s", prefix, spec.Names.Plural, spec.Group)},
+		Spec:     spec,
+	}, nil
+}
+
+// SchemaPrefix returns the name prefix of an APIResourceSchema: "v" followed by the first digits of the SHA-256
+// hash of its spec encoded as JSON, whose object keys are sorted.
+func SchemaPrefix(spec *APIResourceSchemaSpec) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	// Round-trip through a generic value so that the keys of the embedded schemas are sorted as well
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", err
+	}
+	if data, err = json.Marshal(value); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return "v" + hex.EncodeToString(sum[:])[:prefixLength], nil
+}
+
+// NewAPIExport returns the APIExport of the schemas, listed in the given order, with the PermissionClaims of the API.
+func NewAPIExport(schemas []*APIResourceSchema) *APIExport {
+	export := &APIExport{
+		TypeMeta: metav1.TypeMeta{APIVersion: APIVersion, Kind: "APIExport"},
+		Metadata: ObjectMeta{Name: ExportName},
+		Spec:     APIExportSpec{PermissionClaims: PermissionClaims},
+	}
+	for _, schema := range schemas {
+		export.Spec.LatestResourceSchemas = append(export.Spec.LatestResourceSchemas, schema.Metadata.Name)
+	}
+	return export
+}
+
+// NewAPIBinding returns the APIBinding of the APIExport, accepting all its permission claims.
+func NewAPIBinding(export *APIExport) *APIBinding {
+	binding := &APIBinding{
+		TypeMeta: metav1.TypeMeta{APIVersion: APIVersion, Kind: "APIBinding"},
+		Metadata: ObjectMeta{Name: BindingName},
+		Spec: APIBindingSpec{
+			Reference: ExportReference{Workspace: WorkspaceExportReference{ExportName: export.Metadata.Name}},
+		},
+	}
+	for _, claim := range export.Spec.PermissionClaims {
+		binding.Spec.PermissionClaims = append(binding.Spec.PermissionClaims,
+			AcceptablePermissionClaim{PermissionClaim: claim, State: ClaimAccepted})
+	}
+	return binding
+}
+
+// Generate returns the content of the SchemasFile, ExportFile and BindingFile of the CustomResourceDefinitions,
+// by file name. The output only depends on the content of the CustomResourceDefinitions, not on their order.
+func Generate(crds []apiextensionsv1.CustomResourceDefinition) (map[string][]byte, error) {
+	var schemas []*APIResourceSchema
+	for i := range crds {
+		schema, err := NewAPIResourceSchema(&crds[i])
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+	// Sort by resource rather than by name, whose prefix changes with the schema
+	sort.Slice(schemas, func(i, j int) bool {
+		if schemas[i].Spec.Group != schemas[j].Spec.Group {
+			return schemas[i].Spec.Group < schemas[j].Spec.Group
+		}
+		return schemas[i].Spec.Names.Plural < schemas[j].Spec.Names.Plural
+	})
+
+	documents := make([]interface{}, len(schemas))
+	for i := range schemas {
+		documents[i] = schemas[i]
+	}
+	export := NewAPIExport(schemas)
+	files := map[string][]byte{}
+	var err error
+	if files[SchemasFile], err = marshalDocuments(documents...); err != nil {
+		return nil, err
+	}
+	if files[ExportFile], err = marshalDocuments(export); err != nil {
+		return nil, err
+	}
+	if files[BindingFile], err = marshalDocuments(NewAPIBinding(export)); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// marshalDocuments encodes objects as a YAML stream preceded by the generated file header.
+func marshalDocuments(objects ...interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(header)
+	for i, object := range objects {
+		data, err := yaml.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			b.WriteString("---\n")
+		}
+		b.Write(data)
+	}
+	return b.Bytes(), nil
+}
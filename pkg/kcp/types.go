@@ -0,0 +1,112 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kcp
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// The types below mirror the subset of the apis.kcp.dev/v1alpha1 API written by the generator, so that the module
+// does not depend on KCP.
+
+// APIVersion is the API version of the KCP objects
+const APIVersion = "apis.kcp.dev/v1alpha1"
+
+// ObjectMeta is the metadata of a generated KCP object
+type ObjectMeta struct {
+	Name string `json:"name"`
+}
+
+// APIResourceSchema is an immutable snapshot of the schema of a resource, exported by an APIExport
+type APIResourceSchema struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        ObjectMeta            `json:"metadata"`
+	Spec            APIResourceSchemaSpec `json:"spec"`
+}
+
+// APIResourceSchemaSpec is the schema of a resource, as in the spec of a CustomResourceDefinition
+type APIResourceSchemaSpec struct {
+	Group    string                                        `json:"group"`
+	Names    apiextensionsv1.CustomResourceDefinitionNames `json:"names"`
+	Scope    apiextensionsv1.ResourceScope                 `json:"scope"`
+	Versions []APIResourceVersion                          `json:"versions"`
+}
+
+// APIResourceVersion is a version of the schema of a resource
+type APIResourceVersion struct {
+	Name                     string                                           `json:"name"`
+	Served                   bool                                             `json:"served"`
+	Storage                  bool                                             `json:"storage"`
+	Deprecated               bool                                             `json:"deprecated,omitempty"`
+	DeprecationWarning       *string                                          `json:"deprecationWarning,omitempty"`
+	Schema                   runtime.RawExtension                             `json:"schema"`
+	Subresources             apiextensionsv1.CustomResourceSubresources       `json:"subresources,omitempty"`
+	AdditionalPrinterColumns []apiextensionsv1.CustomResourceColumnDefinition `json:"additionalPrinterColumns,omitempty"`
+}
+
+// APIExport exports the latest APIResourceSchemas of an API to the workspaces binding it
+type APIExport struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        ObjectMeta    `json:"metadata"`
+	Spec            APIExportSpec `json:"spec"`
+}
+
+// APIExportSpec lists the schemas of an APIExport and the resources it needs access to in the bound workspaces
+type APIExportSpec struct {
+	LatestResourceSchemas []string          `json:"latestResourceSchemas"`
+	PermissionClaims      []PermissionClaim `json:"permissionClaims,omitempty"`
+}
+
+// PermissionClaim is a resource that an APIExport needs access to in the bound workspaces
+type PermissionClaim struct {
+	Group    string `json:"group"`
+	Resource string `json:"resource"`
+}
+
+// APIBinding binds an APIExport in a workspace
+type APIBinding struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        ObjectMeta     `json:"metadata"`
+	Spec            APIBindingSpec `json:"spec"`
+}
+
+// APIBindingSpec references the bound APIExport and accepts its permission claims
+type APIBindingSpec struct {
+	Reference        ExportReference             `json:"reference"`
+	PermissionClaims []AcceptablePermissionClaim `json:"permissionClaims,omitempty"`
+}
+
+// ExportReference references an APIExport
+type ExportReference struct {
+	Workspace WorkspaceExportReference `json:"workspace"`
+}
+
+// WorkspaceExportReference references an APIExport by name, in the workspace of the APIBinding
+type WorkspaceExportReference struct {
+	ExportName string `json:"exportName"`
+}
+
+// AcceptablePermissionClaim is a permission claim of an APIExport, and whether the APIBinding accepts it
+type AcceptablePermissionClaim struct {
+	PermissionClaim `json:",inline"`
+	State           string `json:"state"`
+}
+
+// ClaimAccepted is the state of an accepted permission claim
+const ClaimAccepted = "Accepted"
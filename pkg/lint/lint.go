@@ -0,0 +1,340 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lint validates YAML manifests of the API offline, without a cluster: each object is checked against
+// the OpenAPI schema of its CustomResourceDefinition and strictly decoded into its Go type, then validated
+// semantically, and references between the objects are checked. Errors point to the file and line at fault.
+//
+// The schema checks are those of the API server: unknown fields are reported, nulls of fields which are not
+// nullable are dropped, then the object is validated against its structural schema, including the uniqueness
+// of list sets and maps. CEL validation rules (x-kubernetes-validations) are not evaluated.
+package lint
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/application-api/config/crd"
+	"github.com/konflux-ci/application-api/pkg/gitutil"
+	"gopkg.in/yaml.v3"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	sigsjson "sigs.k8s.io/json"
+)
+
+// Error is a validation error of a manifest
+type Error struct {
+	// File is the name of the manifest file
+	File string
+
+	// Line is the line of the error in the file, starting at 1
+	Line int
+
+	// Object identifies the object with the error as kind/name, it is empty for errors about a whole document
+	Object string
+
+	// Field is the path of the field with the error, such as spec.source.url, it is empty for errors about a whole object
+	Field string
+
+	// Message describes the error
+	Message string
+}
+
+func (e Error) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:%d: ", e.File, e.Line)
+	if e.Object != "" {
+		b.WriteString(e.Object + ": ")
+	}
+	if e.Field != "" {
+		b.WriteString(e.Field + ": ")
+	}
+	b.WriteString(e.Message)
+	return b.String()
+}
+
+// File is a manifest file, holding one or more YAML documents
+type File struct {
+	// Name is the name of the file reported in errors
+	Name string
+
+	// Data is the content of the file
+	Data []byte
+}
+
+// Options configures a Validator
+type Options struct {
+	// AllowedVendors restricts the vendors of git repository URLs, see gitutil.ValidateURL.
+	// Any vendor is allowed when empty, only the URLs themselves are validated.
+	AllowedVendors []gitutil.Vendor
+
+	// GitHosts maps the host names of self-managed git servers to their vendor, see gitutil.ValidationOptions.
+	GitHosts map[string]gitutil.Vendor
+
+	// Env holds the allow-lists of the Secrets and ConfigMaps that the environment variables of Components may
+	// reference, see v1alpha1.ValidateComponentEnv. Any Secret or ConfigMap is allowed when nil.
+	Env *v1alpha1.ComponentEnvOptions
+
+	// SkipReferences disables the checks of references between objects, for manifests which reference objects
+	// that are defined elsewhere.
+	SkipReferences bool
+}
+
+// Validator validates manifests of the kinds defined by a set of CustomResourceDefinitions
+type Validator struct {
+	crds    map[schema.GroupKind]*apiextensionsv1.CustomResourceDefinition
+	schemas map[schema.GroupVersionKind]*schemaValidator
+	groups  map[string]bool
+	scheme  *runtime.Scheme
+	options Options
+}
+
+// NewValidator returns a Validator for the kinds of the given CustomResourceDefinitions.
+func NewValidator(crds []apiextensionsv1.CustomResourceDefinition, options Options) (*Validator, error) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if len(options.AllowedVendors) == 0 {
+		options.AllowedVendors = []gitutil.Vendor{gitutil.GitHub, gitutil.GitLab, gitutil.Bitbucket, gitutil.Forgejo, gitutil.SelfHosted}
+	}
+
+	if options.Env == nil {
+		options.Env = &v1alpha1.ComponentEnvOptions{AllowedSecrets: []string{"*"}, AllowedConfigMaps: []string{"*"}}
+	}
+
+	v := &Validator{
+		crds:    map[schema.GroupKind]*apiextensionsv1.CustomResourceDefinition{},
+		schemas: map[schema.GroupVersionKind]*schemaValidator{},
+		groups:  map[string]bool{},
+		scheme:  scheme,
+		options: options,
+	}
+	for i := range crds {
+		gk := schema.GroupKind{Group: crds[i].Spec.Group, Kind: crds[i].Spec.Names.Kind}
+		v.crds[gk] = &crds[i]
+		v.groups[gk.Group] = true
+		for j := range crds[i].Spec.Versions {
+			version := &crds[i].Spec.Versions[j]
+			validator, err := newSchemaValidator(version)
+			if err != nil {
+				return nil, fmt.Errorf("invalid schema of version %s of %s: %w", version.Name, crds[i].Name, err)
+			}
+			v.schemas[gk.WithVersion(version.Name)] = validator
+		}
+	}
+	return v, nil
+}
+
+// LoadCRDs reads the CustomResourceDefinitions of the YAML files at the root of fsys, see crd.Load.
+// Documents of other kinds are ignored.
+func LoadCRDs(fsys fs.FS) ([]apiextensionsv1.CustomResourceDefinition, error) {
+	return crd.Load(fsys)
+}
+
+// ReadFiles reads the YAML files at the given paths. Directories are walked recursively, skipping hidden
+// directories; only their .yaml and .yml files are read. Files given explicitly are read whatever their extension.
+func ReadFiles(paths ...string) ([]File, error) {
+	var files []File
+	for _, root := range paths {
+		err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if entry.IsDir() {
+				if path != root && strings.HasPrefix(entry.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if path != root && !isYAMLFile(path) {
+				return nil
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			files = append(files, File{Name: path, Data: data})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// Validate validates the objects of the files, and returns the errors sorted by file and line.
+// Documents which are not objects of a group of the Validator's CustomResourceDefinitions are ignored.
+func (v *Validator) Validate(files []File) []Error {
+	var errs []Error
+	var objects []*object
+	for _, file := range files {
+		documents, err := parseDocuments(file.Data)
+		if err != nil {
+			errs = append(errs, Error{File: file.Name, Line: yamlErrorLine(err), Message: err.Error()})
+			continue
+		}
+		for _, document := range documents {
+			object, objectErrs := v.validateDocument(file.Name, document)
+			errs = append(errs, objectErrs...)
+			if object != nil {
+				objects = append(objects, object)
+			}
+		}
+	}
+	if !v.options.SkipReferences {
+		errs = append(errs, validateReferences(objects)...)
+	}
+
+	sort.SliceStable(errs, func(i, j int) bool {
+		if errs[i].File != errs[j].File {
+			return errs[i].File < errs[j].File
+		}
+		return errs[i].Line < errs[j].Line
+	})
+	return errs
+}
+
+// validateDocument validates a YAML document, and returns its object to check references between objects,
+// or nil if the document is not an object of a known kind.
+func (v *Validator) validateDocument(fileName string, document *yaml.Node) (*object, []Error) {
+	apiVersion := scalarValue(document, "apiVersion")
+	kind := scalarValue(document, "kind")
+	if apiVersion == "" || kind == "" {
+		return nil, nil
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil || !v.groups[gv.Group] {
+		return nil, nil
+	}
+	gvk := gv.WithKind(kind)
+
+	errorAt := func(field string, line int, message string) Error {
+		return Error{File: fileName, Line: line, Object: objectName(kind, document), Field: field, Message: message}
+	}
+	crd, ok := v.crds[gvk.GroupKind()]
+	if !ok {
+		return nil, []Error{errorAt("kind", lineOf(document, "kind"), fmt.Sprintf("unknown kind %s in group %s", kind, gv.Group))}
+	}
+	version := crdVersion(crd, gv.Version)
+	if version == nil {
+		return nil, []Error{errorAt("apiVersion", lineOf(document, "apiVersion"), fmt.Sprintf("version %s of %s is not served", gv.Version, kind))}
+	}
+
+	var errs []Error
+	if validator := v.schemas[gvk]; validator != nil {
+		for _, schemaErr := range validator.validate(document) {
+			errs = append(errs, errorAt(schemaErr.field, schemaErr.line, schemaErr.message))
+		}
+	}
+
+	_, metadata := mappingEntry(document, "metadata")
+	found := &object{
+		fileName:  fileName,
+		document:  document,
+		kind:      kind,
+		namespace: scalarValue(metadata, "namespace"),
+		name:      scalarValue(metadata, "name"),
+	}
+	typed, err := v.scheme.New(gvk)
+	if err != nil {
+		// Kinds with a CustomResourceDefinition but no Go type are only validated against their schema
+		return found, errs
+	}
+	data, err := nodeToJSON(document)
+	if err != nil {
+		return found, append(errs, errorAt("", document.Line, err.Error()))
+	}
+	strictErrs, err := sigsjson.UnmarshalStrict(data, typed, sigsjson.DisallowUnknownFields)
+	if err != nil {
+		// Type mismatches are already reported by the schema checks, unless the Go type and the schema disagree
+		if len(errs) == 0 {
+			errs = append(errs, errorAt("", document.Line, err.Error()))
+		}
+		return found, errs
+	}
+	if len(errs) == 0 {
+		// Unknown fields are already reported by the schema checks, unless the Go type and the schema disagree
+		for _, strictErr := range strictErrs {
+			field := strictErrorField(strictErr)
+			errs = append(errs, errorAt(field, lineOf(document, field), strictErr.Error()))
+		}
+	}
+
+	for _, fieldErr := range v.validateSemantics(typed) {
+		if !reported(errs, fieldErr.Field) {
+			errs = append(errs, errorAt(fieldErr.Field, lineOf(document, fieldErr.Field), fieldErr.ErrorBody()))
+		}
+	}
+
+	found.object = typed
+	return found, errs
+}
+
+// reported returns true if there is already an error for the field or one of its parents.
+func reported(errs []Error, field string) bool {
+	for _, err := range errs {
+		if err.Field == field || strings.HasPrefix(field, err.Field+".") || strings.HasPrefix(field, err.Field+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// crdVersion returns the served version of the CustomResourceDefinition with the given name.
+func crdVersion(crd *apiextensionsv1.CustomResourceDefinition, name string) *apiextensionsv1.CustomResourceDefinitionVersion {
+	for i := range crd.Spec.Versions {
+		if crd.Spec.Versions[i].Name == name && crd.Spec.Versions[i].Served {
+			return &crd.Spec.Versions[i]
+		}
+	}
+	return nil
+}
+
+// parseDocuments parses the YAML documents of a file, leaving out empty documents.
+func parseDocuments(data []byte) ([]*yaml.Node, error) {
+	var documents []*yaml.Node
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		document := &yaml.Node{}
+		err := decoder.Decode(document)
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(document.Content) == 0 || document.Content[0].Kind == yaml.ScalarNode && document.Content[0].Tag == "!!null" {
+			continue
+		}
+		documents = append(documents, document.Content[0])
+	}
+}
+
+func isYAMLFile(name string) bool {
+	extension := filepath.Ext(name)
+	return extension == ".yaml" || extension == ".yml"
+}
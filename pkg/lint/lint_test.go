@@ -0,0 +1,96 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint_test
+
+import (
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/konflux-ci/application-api/config/crd"
+	"github.com/konflux-ci/application-api/pkg/lint"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the tests")
+
+func TestValidateGolden(t *testing.T) {
+	crds, err := crd.Load(crd.Bases)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator, err := lint.NewValidator(crds, lint.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		paths  []string
+		golden string
+	}{
+		{name: "samples", paths: []string{"../../config/samples"}, golden: "testdata/samples.golden"},
+		{name: "manifests", paths: []string{"testdata/manifests.yaml"}, golden: "testdata/manifests.golden"},
+		{name: "syntax error", paths: []string{"testdata/syntax.yaml"}, golden: "testdata/syntax.golden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := lint.ReadFiles(tt.paths...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var b strings.Builder
+			for _, err := range validator.Validate(files) {
+				b.WriteString(err.Error() + "\n")
+			}
+			got := b.String()
+
+			if *update {
+				if err := os.WriteFile(tt.golden, []byte(got), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(tt.golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("errors differ from %s, run the tests with -update to update it:\n got:\n%s\nwant:\n%s", tt.golden, got, want)
+			}
+		})
+	}
+}
+
+func TestValidateSkipReferences(t *testing.T) {
+	crds, err := crd.Load(crd.Bases)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator, err := lint.NewValidator(crds, lint.Options{SkipReferences: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := lint.ReadFiles("testdata/manifests.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range validator.Validate(files) {
+		if strings.Contains(err.Message, "Not found") || strings.Contains(err.Message, "cycle") {
+			t.Errorf("unexpected reference error: %v", err)
+		}
+	}
+}
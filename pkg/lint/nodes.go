@@ -0,0 +1,203 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlErrorLinePattern extracts the line of YAML syntax errors, such as "yaml: line 3: mapping values are not allowed"
+var yamlErrorLinePattern = regexp.MustCompile(`line (\d+)`)
+
+// resolve follows aliases to the node they refer to.
+func resolve(node *yaml.Node) *yaml.Node {
+	for node != nil && node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+// mappingEntry returns the key and value nodes of a key of a mapping node, or nils if the key is not set.
+func mappingEntry(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	node = resolve(node)
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i], resolve(node.Content[i+1])
+		}
+	}
+	return nil, nil
+}
+
+// scalarValue returns the value of a scalar key of a mapping node, or an empty string.
+func scalarValue(node *yaml.Node, key string) string {
+	_, value := mappingEntry(node, key)
+	if value == nil || value.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return value.Value
+}
+
+// objectName identifies the object of a document as kind/name.
+func objectName(kind string, document *yaml.Node) string {
+	_, metadata := mappingEntry(document, "metadata")
+	name := scalarValue(metadata, "name")
+	if name == "" {
+		name = scalarValue(metadata, "generateName")
+	}
+	return kind + "/" + name
+}
+
+// nodeToValue converts a YAML node to the JSON compatible value it represents.
+// Timestamps are kept as strings, as they are in Kubernetes manifests.
+func nodeToValue(node *yaml.Node) (interface{}, error) {
+	node = resolve(node)
+	switch node.Kind {
+	case yaml.MappingNode:
+		value := map[string]interface{}{}
+		var merged []*yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Tag == "!!merge" {
+				merged = append(merged, node.Content[i+1])
+				continue
+			}
+			entry, err := nodeToValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			value[node.Content[i].Value] = entry
+		}
+		// Keys set explicitly take precedence over merged ones
+		for _, mergedNode := range merged {
+			mergedValue, err := nodeToValue(mergedNode)
+			if err != nil {
+				return nil, err
+			}
+			mergedMap, ok := mergedValue.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("line %d: only mappings can be merged", mergedNode.Line)
+			}
+			for key, entry := range mergedMap {
+				if _, set := value[key]; !set {
+					value[key] = entry
+				}
+			}
+		}
+		return value, nil
+	case yaml.SequenceNode:
+		value := make([]interface{}, 0, len(node.Content))
+		for _, item := range node.Content {
+			entry, err := nodeToValue(item)
+			if err != nil {
+				return nil, err
+			}
+			value = append(value, entry)
+		}
+		return value, nil
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null", "!!bool", "!!int", "!!float":
+			var value interface{}
+			if err := node.Decode(&value); err != nil {
+				return nil, err
+			}
+			return value, nil
+		default:
+			return node.Value, nil
+		}
+	default:
+		return nil, fmt.Errorf("line %d: unsupported YAML node", node.Line)
+	}
+}
+
+// nodeToJSON converts a YAML node to JSON.
+func nodeToJSON(node *yaml.Node) ([]byte, error) {
+	value, err := nodeToValue(node)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// lineOf returns the line of a field of a document, given its path as formatted by field.Path, such as
+// spec.source.versions[0].name or metadata.labels[app.kubernetes.io/name]. If the field is not set, the line of
+// its closest parent that is set is returned.
+func lineOf(document *yaml.Node, path string) int {
+	node := resolve(document)
+	line := node.Line
+	for _, segment := range splitPath(path) {
+		if index, err := strconv.Atoi(segment); err == nil && node.Kind == yaml.SequenceNode {
+			if index < 0 || index >= len(node.Content) {
+				break
+			}
+			node = resolve(node.Content[index])
+			line = node.Line
+			continue
+		}
+		key, value := mappingEntry(node, segment)
+		if key == nil {
+			break
+		}
+		node = value
+		line = key.Line
+	}
+	return line
+}
+
+// splitPath splits a field path into its field names, indexes and keys.
+func splitPath(path string) []string {
+	var segments []string
+	for len(path) > 0 {
+		switch path[0] {
+		case '.':
+			path = path[1:]
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				return append(segments, path[1:])
+			}
+			segments = append(segments, path[1:end])
+			path = path[end+1:]
+		default:
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				return append(segments, path)
+			}
+			segments = append(segments, path[:end])
+			path = path[end:]
+		}
+	}
+	return segments
+}
+
+// yamlErrorLine returns the line of a YAML syntax error, or 1 if it is unknown.
+func yamlErrorLine(err error) int {
+	if match := yamlErrorLinePattern.FindStringSubmatch(err.Error()); match != nil {
+		if line, err := strconv.Atoi(match[1]); err == nil {
+			return line
+		}
+	}
+	return 1
+}
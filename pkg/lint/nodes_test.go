@@ -0,0 +1,87 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestLineOf(t *testing.T) {
+	documents, err := parseDocuments([]byte(`apiVersion: v1
+metadata:
+  name: x
+  labels:
+    app.kubernetes.io/name: x
+spec:
+  versions:
+    - name: main
+      revision: main
+    - &second
+      name: devel
+  copy: *second
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	document := documents[0]
+	tests := []struct {
+		path string
+		want int
+	}{
+		{path: "", want: 1},
+		{path: "metadata.name", want: 3},
+		{path: "metadata.labels[app.kubernetes.io/name]", want: 5},
+		{path: "spec.versions[0].revision", want: 9},
+		{path: "spec.versions[1]", want: 10},
+		{path: "spec.versions[1].name", want: 11},
+		{path: "spec.copy.name", want: 11},
+		// Fields which are not set are reported on their closest parent
+		{path: "spec.versions[0].context", want: 8},
+		{path: "spec.versions[5].name", want: 7},
+		{path: "status.conditions", want: 1},
+	}
+	for _, tt := range tests {
+		if got := lineOf(document, tt.path); got != tt.want {
+			t.Errorf("lineOf(%q) = %d, want %d", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestNodeToJSON(t *testing.T) {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(`base: &base
+  a: 1
+  b: x
+merged:
+  <<: *base
+  b: y
+time: 2024-01-01T00:00:00Z
+empty:
+`), &document); err != nil {
+		t.Fatal(err)
+	}
+	data, err := nodeToJSON(document.Content[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"base":{"a":1,"b":"x"},"empty":null,"merged":{"a":1,"b":"y"},"time":"2024-01-01T00:00:00Z"}`
+	if string(data) != want {
+		t.Errorf("nodeToJSON() = %s, want %s", data, want)
+	}
+}
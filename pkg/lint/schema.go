@@ -0,0 +1,100 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	structurallisttype "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/listtype"
+	schemaobjectmeta "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/objectmeta"
+	structuralpruning "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+)
+
+// schemaError is an error of a document against the schema of its kind
+type schemaError struct {
+	field   string
+	line    int
+	message string
+}
+
+// schemaValidator validates objects against the schema of a version of a CustomResourceDefinition, the way the
+// API server does
+type schemaValidator struct {
+	structural *structuralschema.Structural
+	validator  *validate.SchemaValidator
+}
+
+// newSchemaValidator returns a schemaValidator for a version of a CustomResourceDefinition, or nil if the
+// version has no schema.
+func newSchemaValidator(version *apiextensionsv1.CustomResourceDefinitionVersion) (*schemaValidator, error) {
+	if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+		return nil, nil
+	}
+	internal := &apiextensions.CustomResourceValidation{}
+	if err := apiextensionsv1.Convert_v1_CustomResourceValidation_To_apiextensions_CustomResourceValidation(version.Schema, internal, nil); err != nil {
+		return nil, err
+	}
+	structural, err := structuralschema.NewStructural(internal.OpenAPIV3Schema)
+	if err != nil {
+		return nil, err
+	}
+	validator, _, err := apiservervalidation.NewSchemaValidator(internal)
+	if err != nil {
+		return nil, err
+	}
+	return &schemaValidator{structural: structural, validator: validator}, nil
+}
+
+// validate validates a document. Like the API server, it first prunes unknown fields, which are reported as
+// errors, and nulls of fields which are not nullable, which are dropped.
+func (s *schemaValidator) validate(document *yaml.Node) []schemaError {
+	value, err := nodeToValue(document)
+	if err != nil {
+		return []schemaError{{line: document.Line, message: err.Error()}}
+	}
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return []schemaError{{line: document.Line, message: "must be an object"}}
+	}
+
+	var errs []schemaError
+	pruneOptions := structuralpruning.PruneOptions{ReturnPruned: true}
+	for _, path := range structuralpruning.PruneWithOptions(obj, s.structural, true, pruneOptions) {
+		errs = append(errs, schemaError{field: path, line: lineOf(document, path), message: fmt.Sprintf("unknown field %q", path)})
+	}
+	structuraldefaulting.PruneNonNullableNullsWithoutDefaults(obj, s.structural)
+
+	var fieldErrs field.ErrorList
+	if err := schemaobjectmeta.Coerce(nil, obj, s.structural, false, false); err != nil {
+		fieldErrs = append(fieldErrs, err)
+	}
+	fieldErrs = append(fieldErrs, apiservervalidation.ValidateCustomResource(nil, obj, s.validator)...)
+	fieldErrs = append(fieldErrs, schemaobjectmeta.Validate(nil, obj, s.structural, false)...)
+	fieldErrs = append(fieldErrs, structurallisttype.ValidateListSetsAndMaps(nil, s.structural, obj)...)
+	for _, fieldErr := range fieldErrs {
+		errs = append(errs, schemaError{field: fieldErr.Field, line: lineOf(document, fieldErr.Field), message: fieldErr.ErrorBody()})
+	}
+	return errs
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/konflux-ci/application-api/api/v1alpha1"
+	"github.com/konflux-ci/application-api/pkg/buildnudge"
+	"github.com/konflux-ci/application-api/pkg/buildtrigger"
+	"github.com/konflux-ci/application-api/pkg/gitutil"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// strictErrorFieldPattern extracts the field of strict decoding errors, such as: unknown field "spec.foo"
+var strictErrorFieldPattern = regexp.MustCompile(`field "([^"]*)"`)
+
+// object is an object of a manifest, kept to check references between objects
+type object struct {
+	fileName  string
+	document  *yaml.Node
+	kind      string
+	namespace string
+	name      string
+
+	// object is the decoded object, or nil if the document could not be decoded into its Go type
+	object runtime.Object
+}
+
+// validateSemantics validates a decoded object beyond its schema.
+func (v *Validator) validateSemantics(obj runtime.Object) field.ErrorList {
+	var errs field.ErrorList
+	if accessor, err := meta.Accessor(obj); err == nil {
+		// The namespace is optional, as it is often only set when the manifests are applied
+		errs = append(errs, apivalidation.ValidateObjectMetaAccessor(accessor, accessor.GetNamespace() != "",
+			apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	}
+	errs = append(errs, fieldErrors(v1alpha1.ValidateListMapKeys(obj))...)
+	switch typed := obj.(type) {
+	case *v1alpha1.Application:
+		errs = append(errs, fieldErrors(gitutil.ValidateApplicationURLs(typed, v.gitOptions()))...)
+	case *v1alpha1.Component:
+		errs = append(errs, fieldErrors(gitutil.ValidateComponentURLs(typed, v.gitOptions()))...)
+		errs = append(errs, fieldErrors(v1alpha1.ValidateComponentSource(typed))...)
+		errs = append(errs, fieldErrors(buildtrigger.ValidateComponent(typed))...)
+		errs = append(errs, validateVersionNames(typed.Spec.Source.Versions)...)
+		errs = append(errs, fieldErrors(v1alpha1.ValidateComponentEnv(typed, *v.options.Env))...)
+	case *v1alpha1.ComponentDetectionQuery:
+		errs = append(errs, fieldErrors(gitutil.ValidateComponentDetectionQueryURLs(typed, v.gitOptions()))...)
+	case *v1alpha1.Snapshot:
+		if _, found := typed.Annotations[v1alpha1.SnapshotContentHashAnnotation]; found {
+			path := field.NewPath("metadata", "annotations").Key(v1alpha1.SnapshotContentHashAnnotation)
+			if valid, err := v1alpha1.VerifySnapshotContentHash(typed); err != nil {
+				errs = append(errs, field.Invalid(path, typed.Annotations[v1alpha1.SnapshotContentHashAnnotation], err.Error()))
+			} else if !valid {
+				errs = append(errs, field.Invalid(path, typed.Annotations[v1alpha1.SnapshotContentHashAnnotation], "does not match the content of the snapshot"))
+			}
+		}
+	}
+	return errs
+}
+
+// validateVersionNames checks that the versions of a Component have unique sanitized names, which is required
+// to plan their onboarding, see v1alpha1.PlanComponentVersions.
+func validateVersionNames(versions []v1alpha1.ComponentVersion) field.ErrorList {
+	var errs field.ErrorList
+	names := map[string]string{}
+	for i, version := range versions {
+		path := field.NewPath("spec", "source", "versions").Index(i).Child("name")
+		name := v1alpha1.SanitizeVersionName(version.Name)
+		if name == "" {
+			errs = append(errs, field.Invalid(path, version.Name, fmt.Sprintf(v1alpha1.InvalidVersionNameError, version.Name)))
+			continue
+		}
+		if other, found := names[name]; found {
+			errs = append(errs, field.Invalid(path, version.Name, fmt.Sprintf(v1alpha1.DuplicateVersionNameError, other, version.Name, name)))
+			continue
+		}
+		names[name] = version.Name
+	}
+	return errs
+}
+
+// validateReferences checks the references between the objects of the manifests.
+func validateReferences(objects []*object) []Error {
+	type key struct{ namespace, name string }
+	applications := map[key]bool{}
+	components := map[key]*v1alpha1.Component{}
+	componentsByNamespace := map[string][]v1alpha1.Component{}
+	for _, o := range objects {
+		switch o.kind {
+		case "Application":
+			applications[key{o.namespace, o.name}] = true
+		case "Component":
+			// Components which could not be decoded still exist, without any known spec
+			component, ok := o.object.(*v1alpha1.Component)
+			if !ok {
+				component = &v1alpha1.Component{ObjectMeta: metav1.ObjectMeta{Namespace: o.namespace, Name: o.name}}
+			}
+			components[key{o.namespace, o.name}] = component
+			componentsByNamespace[o.namespace] = append(componentsByNamespace[o.namespace], *component)
+		}
+	}
+
+	var errs []Error
+	report := func(o *object, kind, name string, fieldErrs field.ErrorList) {
+		for _, fieldErr := range fieldErrs {
+			errs = append(errs, Error{
+				File:    o.fileName,
+				Line:    lineOf(o.document, fieldErr.Field),
+				Object:  kind + "/" + name,
+				Field:   fieldErr.Field,
+				Message: fieldErr.ErrorBody(),
+			})
+		}
+	}
+	applicationExists := func(namespace, name string, path *field.Path) field.ErrorList {
+		if name == "" || applications[key{namespace, name}] {
+			return nil
+		}
+		return field.ErrorList{field.NotFound(path, name)}
+	}
+
+	for _, o := range objects {
+		switch typed := o.object.(type) {
+		case *v1alpha1.Component:
+			fieldErrs := applicationExists(typed.Namespace, typed.Spec.Application, field.NewPath("spec", "application"))
+			fieldErrs = append(fieldErrs, fieldErrors(buildnudge.ValidateComponent(typed, componentsByNamespace[typed.Namespace]))...)
+			report(o, "Component", typed.Name, fieldErrs)
+		case *v1alpha1.Snapshot:
+			fieldErrs := applicationExists(typed.Namespace, typed.Spec.Application, field.NewPath("spec", "application"))
+			for i, snapshotComponent := range typed.Spec.Components {
+				path := field.NewPath("spec", "components").Index(i).Child("name")
+				component := components[key{typed.Namespace, snapshotComponent.Name}]
+				switch {
+				case component == nil:
+					fieldErrs = append(fieldErrs, field.NotFound(path, snapshotComponent.Name))
+				case typed.Spec.Application != "" && component.Spec.Application != "" && component.Spec.Application != typed.Spec.Application:
+					fieldErrs = append(fieldErrs, field.Invalid(path, snapshotComponent.Name,
+						fmt.Sprintf("component belongs to application %s, not to application %s", component.Spec.Application, typed.Spec.Application)))
+				}
+			}
+			report(o, "Snapshot", typed.Name, fieldErrs)
+		}
+	}
+	return errs
+}
+
+// fieldErrors returns the field errors aggregated in an error returned by a validation function.
+func fieldErrors(err error) field.ErrorList {
+	if err == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	var aggregate utilerrors.Aggregate
+	if errors.As(err, &aggregate) {
+		for _, err := range aggregate.Errors() {
+			errs = append(errs, fieldErrors(err)...)
+		}
+		return errs
+	}
+	var fieldErr *field.Error
+	if errors.As(err, &fieldErr) {
+		return field.ErrorList{fieldErr}
+	}
+	return field.ErrorList{field.InternalError(nil, err)}
+}
+
+// strictErrorField returns the field of a strict decoding error, or an empty string.
+func strictErrorField(err error) string {
+	if match := strictErrorFieldPattern.FindStringSubmatch(err.Error()); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// gitOptions returns the options of the validation of git repository URLs.
+func (v *Validator) gitOptions() gitutil.ValidationOptions {
+	return gitutil.ValidationOptions{AllowedVendors: v.options.AllowedVendors, Hosts: v.options.GitHosts}
+}